@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"log"
+	"net/netip"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +22,8 @@ func LoadEnv() error {
 
 	Env.APIURL = os.Getenv("API_URL")
 	Env.APIPort = os.Getenv("API_PORT")
+	Env.InternalAddr = os.Getenv("INTERNAL_ADDR")
+	Env.AdminToken = os.Getenv("ADMIN_TOKEN")
 
 	Env.DBUser = os.Getenv("DB_USER")
 	Env.DBPassword = os.Getenv("DB_PASSWORD")
@@ -37,28 +41,327 @@ func LoadEnv() error {
 	}
 	Env.RequestTimeout = timeout
 
-	if Env.Key.PrivateKey == "" || Env.Key.PublicKey == "" {
+	if Env.LinkNotActive.StatusCode, err = getEnvInt("LINK_NOT_ACTIVE_STATUS", 404); err != nil {
+		return err
+	}
+	Env.LinkNotActive.RedirectURL = os.Getenv("LINK_NOT_ACTIVE_URL")
+
+	if Env.VisitQueue.Size, err = getEnvInt("VISIT_QUEUE_SIZE", 10000); err != nil {
+		return err
+	}
+	if Env.VisitQueue.Workers, err = getEnvInt("VISIT_QUEUE_WORKERS", 4); err != nil {
+		return err
+	}
+	if Env.VisitQueue.BatchSize, err = getEnvInt("VISIT_BATCH_SIZE", 200); err != nil {
+		return err
+	}
+	if Env.VisitQueue.FlushInterval, err = getEnvDuration("VISIT_FLUSH_INTERVAL", time.Second); err != nil {
+		return err
+	}
+	if Env.VisitQueue.EnqueueTimeout, err = getEnvDuration("VISIT_ENQUEUE_TIMEOUT", 50*time.Millisecond); err != nil {
+		return err
+	}
+	if Env.VisitQueue.DrainTimeout, err = getEnvDuration("VISIT_DRAIN_TIMEOUT", 10*time.Second); err != nil {
+		return err
+	}
+
+	if Env.LinkCache.Size, err = getEnvInt("LINK_CACHE_SIZE", 10000); err != nil {
+		return err
+	}
+	if Env.LinkCache.TTL, err = getEnvDuration("LINK_CACHE_TTL", 5*time.Minute); err != nil {
+		return err
+	}
+	if Env.LinkCache.NegativeTTL, err = getEnvDuration("LINK_CACHE_NEGATIVE_TTL", 30*time.Second); err != nil {
+		return err
+	}
+
+	if Env.SessionCache.Size, err = getEnvInt("SESSION_CACHE_SIZE", 10000); err != nil {
+		return err
+	}
+	if Env.SessionCache.TTL, err = getEnvDuration("SESSION_CACHE_TTL", 30*time.Second); err != nil {
+		return err
+	}
+
+	if Env.UnlockLimit.Size, err = getEnvInt("UNLOCK_LIMIT_SIZE", 10000); err != nil {
+		return err
+	}
+	if Env.UnlockLimit.MaxAttempts, err = getEnvInt("UNLOCK_MAX_ATTEMPTS", 5); err != nil {
+		return err
+	}
+	if Env.UnlockLimit.LinkMaxAttempts, err = getEnvInt("UNLOCK_LINK_MAX_ATTEMPTS", 100); err != nil {
+		return err
+	}
+	if Env.UnlockLimit.Window, err = getEnvDuration("UNLOCK_ATTEMPT_WINDOW", 15*time.Minute); err != nil {
+		return err
+	}
+
+	if Env.PermanentRedirectMaxAge, err = getEnvDuration("PERMANENT_REDIRECT_MAX_AGE", 24*time.Hour); err != nil {
+		return err
+	}
+
+	Env.CountryHeader = os.Getenv("COUNTRY_HEADER")
+	if Env.TrustedProxies, err = getEnvPrefixes("TRUSTED_PROXIES"); err != nil {
+		return err
+	}
+	if Env.CountryHeader != "" && len(Env.TrustedProxies) == 0 {
+		return fmt.Errorf("TRUSTED_PROXIES is required when COUNTRY_HEADER is set")
+	}
+
+	Env.GeoIP.DatabasePath = os.Getenv("GEOIP_DATABASE_PATH")
+	if Env.GeoIP.ReloadInterval, err = getEnvDuration("GEOIP_RELOAD_INTERVAL", time.Minute); err != nil {
+		return err
+	}
+
+	Env.ReservedCodes = getEnvList("RESERVED_SHORT_CODES", []string{"api", "admin", "static", "assets", "health", "metrics", "favicon.ico", "robots.txt"})
+
+	Env.ShortCode.Alphabet = os.Getenv("SHORT_CODE_ALPHABET")
+	if Env.ShortCode.Alphabet == "" {
+		Env.ShortCode.Alphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	}
+	if Env.ShortCode.Length, err = getEnvInt("SHORT_CODE_LENGTH", 8); err != nil {
+		return err
+	}
+	if Env.ShortCode.MaxLength, err = getEnvInt("SHORT_CODE_MAX_LENGTH", 20); err != nil {
+		return err
+	}
+	if Env.ShortCode.MaxAttempts, err = getEnvInt("SHORT_CODE_MAX_ATTEMPTS", 6); err != nil {
+		return err
+	}
+	if err := validateShortCode(Env.ShortCode); err != nil {
+		return fmt.Errorf("invalid short code settings: %w", err)
+	}
+
+	if Env.LinkBatchMaxItems, err = getEnvInt("LINK_BATCH_MAX_ITEMS", 1000); err != nil {
+		return err
+	}
+
+	if Env.AccessTokenTTL, err = getEnvDuration("ACCESS_TOKEN_TTL", 15*time.Minute); err != nil {
+		return err
+	}
+	if Env.RefreshTokenTTL, err = getEnvDuration("REFRESH_TOKEN_TTL", 7*24*time.Hour); err != nil {
+		return err
+	}
+	if Env.RefreshTokenReuseGrace, err = getEnvDuration("REFRESH_TOKEN_REUSE_GRACE", 10*time.Second); err != nil {
+		return err
+	}
+	if Env.MaxSessionLifetime, err = getEnvDuration("MAX_SESSION_LIFETIME", 30*24*time.Hour); err != nil {
+		return err
+	}
+	if Env.AccessTokenTTL <= 0 || Env.RefreshTokenTTL < Env.AccessTokenTTL || Env.MaxSessionLifetime < Env.RefreshTokenTTL {
+		return fmt.Errorf("invalid token lifetimes")
+	}
+
+	Env.Mailer.Driver = os.Getenv("MAILER_DRIVER")
+	if Env.Mailer.Driver == "" {
+		Env.Mailer.Driver = models.MailerDriverOutbox
+	}
+	Env.Mailer.From = os.Getenv("MAILER_FROM")
+	if Env.Mailer.From == "" {
+		Env.Mailer.From = "Link Fizz <no-reply@localhost>"
+	}
+	Env.Mailer.OutboxDir = os.Getenv("MAILER_OUTBOX_DIR")
+	if Env.Mailer.OutboxDir == "" {
+		Env.Mailer.OutboxDir = "outbox"
+	}
+	Env.Mailer.SMTP.Host = os.Getenv("SMTP_HOST")
+	if Env.Mailer.SMTP.Port, err = getEnvInt("SMTP_PORT", 587); err != nil {
+		return err
+	}
+	Env.Mailer.SMTP.Username = os.Getenv("SMTP_USERNAME")
+	Env.Mailer.SMTP.Password = os.Getenv("SMTP_PASSWORD")
+	if Env.Mailer.SMTP.Timeout, err = getEnvDuration("SMTP_TIMEOUT", 10*time.Second); err != nil {
+		return err
+	}
+	if Env.Mailer.Driver == models.MailerDriverSMTP && Env.Mailer.SMTP.Host == "" {
+		return fmt.Errorf("SMTP_HOST is required when MAILER_DRIVER is smtp")
+	}
+
+	Env.EmailVerification.URL = os.Getenv("EMAIL_VERIFICATION_URL")
+	if Env.EmailVerification.URL == "" {
+		Env.EmailVerification.URL = strings.TrimSuffix(Env.APIURL, "/") + "/v1/verify-email"
+	}
+	if Env.EmailVerification.TTL, err = getEnvDuration("EMAIL_VERIFICATION_TTL", 24*time.Hour); err != nil {
+		return err
+	}
+	if Env.EmailVerification.ResendInterval, err = getEnvDuration("EMAIL_VERIFICATION_RESEND_INTERVAL", time.Minute); err != nil {
+		return err
+	}
+	if Env.EmailVerification.RequiredForLinks, err = getEnvBool("EMAIL_VERIFICATION_REQUIRED_FOR_LINKS", false); err != nil {
+		return err
+	}
+
+	if len(Env.KeyRing.Keys) == 0 {
+		if Env.KeyRing.Keys, err = loadKeyRing(); err != nil {
+			return err
+		}
+	}
+	Env.KeyRing.PrimaryKeyID = os.Getenv("JWT_PRIMARY_KEY_ID")
+
+	return nil
+}
+
+func LoadKeyFromFile(filename string) (string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file %s: %w", filename, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func loadKeyRing() ([]models.Key, error) {
+	entries := getEnvList("JWT_KEYS", nil)
+	if len(entries) == 0 {
 		privateKey, err := LoadKeyFromFile(os.Getenv("KEY_ECDSA_PRIVATE"))
 		if err != nil {
-			return fmt.Errorf("load private key: %w", err)
+			return nil, fmt.Errorf("load private key: %w", err)
 		}
 
 		publicKey, err := LoadKeyFromFile(os.Getenv("KEY_ECDSA_PUBLIC"))
 		if err != nil {
-			return fmt.Errorf("load public key: %w", err)
+			return nil, fmt.Errorf("load public key: %w", err)
 		}
 
-		Env.Key.PrivateKey = privateKey
-		Env.Key.PublicKey = publicKey
+		return []models.Key{{PrivateKey: privateKey, PublicKey: publicKey}}, nil
+	}
+
+	keys := make([]models.Key, 0, len(entries))
+	for _, entry := range entries {
+		id, filename, found := strings.Cut(entry, "=")
+		if !found || strings.TrimSpace(id) == "" {
+			return nil, fmt.Errorf("invalid JWT_KEYS entry %q: expected kid=path", entry)
+		}
+
+		pemKey, err := LoadKeyFromFile(strings.TrimSpace(filename))
+		if err != nil {
+			return nil, fmt.Errorf("load key %s: %w", id, err)
+		}
+
+		key := models.Key{ID: strings.TrimSpace(id)}
+		if strings.Contains(pemKey, "PRIVATE KEY") {
+			key.PrivateKey = pemKey
+		} else {
+			key.PublicKey = pemKey
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func validateShortCode(shortCode models.ShortCode) error {
+	// Codes are used as raw path segments, so the alphabet is limited to RFC 3986 unreserved characters.
+	if len(shortCode.Alphabet) < 2 {
+		return fmt.Errorf("alphabet must have at least 2 characters")
+	}
+
+	seen := make(map[rune]struct{}, len(shortCode.Alphabet))
+	for _, char := range shortCode.Alphabet {
+		if !isUnreservedChar(char) {
+			return fmt.Errorf("alphabet character %q is not one of A-Z a-z 0-9 - . _ ~", char)
+		}
+
+		if _, found := seen[char]; found {
+			return fmt.Errorf("alphabet has duplicate character %q", char)
+		}
+		seen[char] = struct{}{}
+	}
+
+	if shortCode.Length < 1 || shortCode.Length > shortCode.MaxLength {
+		return fmt.Errorf("length must be between 1 and SHORT_CODE_MAX_LENGTH")
+	}
+
+	if shortCode.MaxLength > models.MaxShortCodeLength {
+		return fmt.Errorf("max length cannot exceed %d", models.MaxShortCodeLength)
 	}
 
 	return nil
 }
 
-func LoadKeyFromFile(filename string) (string, error) {
-	data, err := os.ReadFile(filename)
+func isUnreservedChar(char rune) bool {
+	switch {
+	case char >= 'a' && char <= 'z', char >= 'A' && char <= 'Z', char >= '0' && char <= '9':
+		return true
+	}
+
+	return strings.ContainsRune("-._~", char)
+}
+
+func getEnvInt(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
 	if err != nil {
-		return "", fmt.Errorf("failed to read file %s: %w", filename, err)
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
 	}
-	return strings.TrimSpace(string(data)), nil
+
+	return parsed, nil
+}
+
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	return parsed, nil
+}
+
+func getEnvBool(key string, fallback bool) (bool, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	return parsed, nil
+}
+
+func getEnvPrefixes(key string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, item := range getEnvList(key, nil) {
+		if !strings.Contains(item, "/") {
+			addr, err := netip.ParseAddr(item)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s entry %q: %w", key, item, err)
+			}
+			item = netip.PrefixFrom(addr, addr.BitLen()).String()
+		}
+
+		prefix, err := netip.ParsePrefix(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry %q: %w", key, item, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+func getEnvList(key string, fallback []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	var values []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+
+	return values
 }
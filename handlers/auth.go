@@ -17,6 +17,7 @@ type AuthHandler interface {
 	Login(w http.ResponseWriter, r *http.Request)
 	Logout(w http.ResponseWriter, r *http.Request)
 	Register(w http.ResponseWriter, r *http.Request)
+	RefreshToken(w http.ResponseWriter, r *http.Request)
 }
 
 type authHandler struct {
@@ -108,6 +109,12 @@ func (a *authHandler) Login(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if errors.Is(err, models.ErrUserDisabled) {
+			logger.Warn("user disabled", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
 		logger.Error("login error", slog.String("error", err.Error()))
 		responses.NoContent(w, http.StatusInternalServerError)
 		return
@@ -116,6 +123,48 @@ func (a *authHandler) Login(w http.ResponseWriter, r *http.Request) {
 	responses.JSON(w, http.StatusOK, response)
 }
 
+func (a *authHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "auth",
+		"method", "RefreshToken",
+	)
+
+	var payload models.RefreshTokenPayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	if payload.RefreshToken == "" {
+		logger.Error("empty refresh token")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	ipAddress := r.Header.Get("X-Forwarded-For")
+	if ipAddress == "" {
+		ipAddress = r.RemoteAddr
+	}
+	userAgent := r.UserAgent()
+
+	response, err := a.as.RefreshToken(r.Context(), payload.RefreshToken, ipAddress, userAgent)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidRefreshToken) || errors.Is(err, models.ErrRefreshTokenReused) || errors.Is(err, models.ErrUserDisabled) {
+			logger.Warn("refresh token rejected", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusUnauthorized)
+			return
+		}
+
+		logger.Error("refresh token", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
 func (a *authHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	logger := slog.With(
 		"handler", "logout",
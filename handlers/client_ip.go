@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+)
+
+// clientIP returns the direct peer's address unless that peer is a trusted
+// proxy, in which case X-Forwarded-For is walked from the right and the first
+// hop that is not itself a trusted proxy is taken as the client.
+func clientIP(r *http.Request) string {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	if !isTrustedProxy(r.RemoteAddr) {
+		return ip
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+
+		ip = addr.Unmap().String()
+		if !isTrustedAddr(addr) {
+			break
+		}
+	}
+
+	return ip
+}
+
+// isTrustedProxy reports whether the direct peer is a configured proxy whose forwarding headers can be believed.
+func isTrustedProxy(remoteAddr string) bool {
+	addrPort, err := netip.ParseAddrPort(remoteAddr)
+	if err != nil {
+		return false
+	}
+
+	return isTrustedAddr(addrPort.Addr())
+}
+
+func isTrustedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range config.Env.TrustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
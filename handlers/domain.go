@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/pkgs/requestcontext"
+	"github.com/g-villarinho/link-fizz-api/responses"
+	"github.com/g-villarinho/link-fizz-api/services"
+	"github.com/gorilla/mux"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type DomainHandler interface {
+	CreateDomain(w http.ResponseWriter, r *http.Request)
+	GetDomains(w http.ResponseWriter, r *http.Request)
+	VerifyDomain(w http.ResponseWriter, r *http.Request)
+	DeleteDomain(w http.ResponseWriter, r *http.Request)
+}
+
+type domainHandler struct {
+	i  *di.Injector
+	ds services.DomainService
+	rc requestcontext.RequestContext
+}
+
+func NewDomainHandler(i *di.Injector) (DomainHandler, error) {
+	domainService, err := di.Invoke[services.DomainService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.DomainService: %w", err)
+	}
+
+	requestContext, err := di.Invoke[requestcontext.RequestContext](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke requestcontext.RequestContext: %w", err)
+	}
+
+	return &domainHandler{
+		i:  i,
+		ds: domainService,
+		rc: requestContext,
+	}, nil
+}
+
+func (d *domainHandler) CreateDomain(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "domain",
+		"method", "CreateDomain",
+	)
+
+	var payload models.DomainPayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	userID, found := d.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := d.ds.CreateDomain(r.Context(), userID, payload)
+	if err != nil {
+		if err == models.ErrInvalidDomain {
+			logger.Error("invalid domain")
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrDomainAlreadyExists {
+			logger.Error("domain already exists")
+			responses.NoContent(w, http.StatusConflict)
+			return
+		}
+
+		logger.Error("create domain", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusCreated, response)
+}
+
+func (d *domainHandler) GetDomains(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "domain",
+		"method", "GetDomains",
+	)
+
+	userID, found := d.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := d.ds.GetDomains(r.Context(), userID)
+	if err != nil {
+		logger.Error("get domains", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (d *domainHandler) VerifyDomain(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "domain",
+		"method", "VerifyDomain",
+	)
+
+	domainID := mux.Vars(r)["id"]
+	if domainID == "" {
+		logger.Error("empty domain ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := d.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := d.ds.VerifyDomain(r.Context(), userID, domainID)
+	if err != nil {
+		if err == models.ErrDomainNotFound {
+			logger.Error("domain not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrDomainNotBelongToUser {
+			logger.Error("domain does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		if err == models.ErrDomainVerificationFailed {
+			logger.Error("domain verification failed")
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrDomainAlreadyExists {
+			logger.Error("domain already verified by another user")
+			responses.NoContent(w, http.StatusConflict)
+			return
+		}
+
+		logger.Error("verify domain", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (d *domainHandler) DeleteDomain(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "domain",
+		"method", "DeleteDomain",
+	)
+
+	domainID := mux.Vars(r)["id"]
+	if domainID == "" {
+		logger.Error("empty domain ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := d.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	if err := d.ds.DeleteDomain(r.Context(), userID, domainID); err != nil {
+		if err == models.ErrDomainNotFound {
+			logger.Error("domain not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrDomainNotBelongToUser {
+			logger.Error("domain does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		if err == models.ErrDomainInUse {
+			logger.Error("domain still has links")
+			responses.Error(w, http.StatusConflict, err)
+			return
+		}
+
+		logger.Error("delete domain", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.NoContent(w, http.StatusNoContent)
+}
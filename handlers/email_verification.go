@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/pkgs/requestcontext"
+	"github.com/g-villarinho/link-fizz-api/responses"
+	"github.com/g-villarinho/link-fizz-api/services"
+)
+
+type EmailVerificationHandler interface {
+	VerifyEmail(w http.ResponseWriter, r *http.Request)
+	ResendVerification(w http.ResponseWriter, r *http.Request)
+}
+
+type emailVerificationHandler struct {
+	i   *di.Injector
+	evs services.EmailVerificationService
+	rc  requestcontext.RequestContext
+}
+
+func NewEmailVerificationHandler(i *di.Injector) (EmailVerificationHandler, error) {
+	emailVerificationService, err := di.Invoke[services.EmailVerificationService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.EmailVerificationService: %w", err)
+	}
+
+	requestContext, err := di.Invoke[requestcontext.RequestContext](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke requestcontext.RequestContext: %w", err)
+	}
+
+	return &emailVerificationHandler{
+		i:   i,
+		evs: emailVerificationService,
+		rc:  requestContext,
+	}, nil
+}
+
+func (e *emailVerificationHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "email_verification",
+		"method", "VerifyEmail",
+	)
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		logger.Error("empty verification token")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	if err := e.evs.VerifyEmail(r.Context(), token); err != nil {
+		if err == models.ErrInvalidVerificationToken {
+			logger.Warn("invalid verification token")
+			responses.Error(w, http.StatusBadRequest, err)
+			return
+		}
+
+		logger.Error("verify email", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.NoContent(w, http.StatusNoContent)
+}
+
+func (e *emailVerificationHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "email_verification",
+		"method", "ResendVerification",
+	)
+
+	userID, found := e.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	if err := e.evs.ResendVerification(r.Context(), userID); err != nil {
+		if err == models.ErrUserNotFound {
+			logger.Error("user not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrEmailAlreadyVerified {
+			logger.Warn("email already verified")
+			responses.Error(w, http.StatusConflict, err)
+			return
+		}
+
+		if err == models.ErrVerificationResendTooSoon {
+			logger.Warn("verification email sent too recently")
+			responses.Error(w, http.StatusTooManyRequests, err)
+			return
+		}
+
+		logger.Error("resend verification", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.NoContent(w, http.StatusAccepted)
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/pkgs/requestcontext"
+	"github.com/g-villarinho/link-fizz-api/responses"
+	"github.com/g-villarinho/link-fizz-api/services"
+	"github.com/gorilla/mux"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type FolderHandler interface {
+	CreateFolder(w http.ResponseWriter, r *http.Request)
+	GetFolders(w http.ResponseWriter, r *http.Request)
+	UpdateFolder(w http.ResponseWriter, r *http.Request)
+	DeleteFolder(w http.ResponseWriter, r *http.Request)
+}
+
+type folderHandler struct {
+	i  *di.Injector
+	fs services.FolderService
+	rc requestcontext.RequestContext
+}
+
+func NewFolderHandler(i *di.Injector) (FolderHandler, error) {
+	folderService, err := di.Invoke[services.FolderService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.FolderService: %w", err)
+	}
+
+	requestContext, err := di.Invoke[requestcontext.RequestContext](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke requestcontext.RequestContext: %w", err)
+	}
+
+	return &folderHandler{
+		i:  i,
+		fs: folderService,
+		rc: requestContext,
+	}, nil
+}
+
+func (f *folderHandler) CreateFolder(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "folder",
+		"method", "CreateFolder",
+	)
+
+	var payload models.FolderPayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	userID, found := f.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := f.fs.CreateFolder(r.Context(), userID, payload)
+	if err != nil {
+		if err == models.ErrInvalidFolderName {
+			logger.Error("invalid folder name")
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrFolderAlreadyExists {
+			logger.Error("folder already exists")
+			responses.NoContent(w, http.StatusConflict)
+			return
+		}
+
+		logger.Error("create folder", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusCreated, response)
+}
+
+func (f *folderHandler) GetFolders(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "folder",
+		"method", "GetFolders",
+	)
+
+	userID, found := f.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := f.fs.GetFolders(r.Context(), userID)
+	if err != nil {
+		logger.Error("get folders", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (f *folderHandler) UpdateFolder(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "folder",
+		"method", "UpdateFolder",
+	)
+
+	folderID := mux.Vars(r)["id"]
+	if folderID == "" {
+		logger.Error("empty folder ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	var payload models.FolderPayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	userID, found := f.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := f.fs.UpdateFolder(r.Context(), userID, folderID, payload)
+	if err != nil {
+		if err == models.ErrInvalidFolderName {
+			logger.Error("invalid folder name")
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrFolderNotFound {
+			logger.Error("folder not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrFolderNotBelongToUser {
+			logger.Error("folder does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		if err == models.ErrFolderAlreadyExists {
+			logger.Error("folder already exists")
+			responses.NoContent(w, http.StatusConflict)
+			return
+		}
+
+		logger.Error("update folder", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (f *folderHandler) DeleteFolder(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "folder",
+		"method", "DeleteFolder",
+	)
+
+	folderID := mux.Vars(r)["id"]
+	if folderID == "" {
+		logger.Error("empty folder ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := f.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	if err := f.fs.DeleteFolder(r.Context(), userID, folderID); err != nil {
+		if err == models.ErrFolderNotFound {
+			logger.Error("folder not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrFolderNotBelongToUser {
+			logger.Error("folder does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		logger.Error("delete folder", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.NoContent(w, http.StatusNoContent)
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/responses"
+	"github.com/g-villarinho/link-fizz-api/services"
+)
+
+const jwksMaxAge = 300
+
+type JWKSHandler interface {
+	GetJWKS(w http.ResponseWriter, r *http.Request)
+}
+
+type jwksHandler struct {
+	i  *di.Injector
+	kr services.KeyRing
+}
+
+func NewJWKSHandler(i *di.Injector) (JWKSHandler, error) {
+	keyRing, err := di.Invoke[services.KeyRing](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.KeyRing: %w", err)
+	}
+
+	return &jwksHandler{
+		i:  i,
+		kr: keyRing,
+	}, nil
+}
+
+func (j *jwksHandler) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", jwksMaxAge))
+	responses.JSON(w, http.StatusOK, j.kr.JWKS())
+}
@@ -1,11 +1,17 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/g-villarinho/link-fizz-api/config"
 	"github.com/g-villarinho/link-fizz-api/models"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
 	"github.com/g-villarinho/link-fizz-api/pkgs/requestcontext"
@@ -16,18 +22,39 @@ import (
 	"github.com/gorilla/mux"
 )
 
+const linkAccessCookieName = "link_access"
+
+const (
+	linkDestinationCookieName   = "link_destination"
+	linkDestinationCookieMaxAge = 30 * 24 * time.Hour
+)
+
+const linkImportMaxBytes = 10 << 20
+
 type LinkHandler interface {
 	CreateLink(w http.ResponseWriter, r *http.Request)
+	CreateLinksBatch(w http.ResponseWriter, r *http.Request)
+	ImportLinks(w http.ResponseWriter, r *http.Request)
 	RedirectLink(w http.ResponseWriter, r *http.Request)
+	UnlockLink(w http.ResponseWriter, r *http.Request)
 	GetLinks(w http.ResponseWriter, r *http.Request)
 	GetLinkDetails(w http.ResponseWriter, r *http.Request)
+	UpdateLink(w http.ResponseWriter, r *http.Request)
+	DeleteLink(w http.ResponseWriter, r *http.Request)
+	GetLinkStats(w http.ResponseWriter, r *http.Request)
+	GetLinkQRCode(w http.ResponseWriter, r *http.Request)
+	ExportLinks(w http.ResponseWriter, r *http.Request)
+	ExportLinkVisits(w http.ResponseWriter, r *http.Request)
 }
 
 type linkHandler struct {
-	i  *di.Injector
-	ls services.LinkService
-	rs services.RedirectService
-	rc requestcontext.RequestContext
+	i   *di.Injector
+	ls  services.LinkService
+	rs  services.RedirectService
+	lvs services.LinkVisitService
+	qrs services.QRService
+	es  services.ExportService
+	rc  requestcontext.RequestContext
 }
 
 func NewLinkHandler(i *di.Injector) (LinkHandler, error) {
@@ -41,16 +68,34 @@ func NewLinkHandler(i *di.Injector) (LinkHandler, error) {
 		return nil, fmt.Errorf("invoke services.RedirectService: %w", err)
 	}
 
+	linkVisitService, err := di.Invoke[services.LinkVisitService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.LinkVisitService: %w", err)
+	}
+
+	qrService, err := di.Invoke[services.QRService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.QRService: %w", err)
+	}
+
+	exportService, err := di.Invoke[services.ExportService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.ExportService: %w", err)
+	}
+
 	requestContext, err := di.Invoke[requestcontext.RequestContext](i)
 	if err != nil {
 		return nil, fmt.Errorf("invoke requestcontext.RequestContext: %w", err)
 	}
 
 	return &linkHandler{
-		i:  i,
-		ls: linkService,
-		rs: redirectService,
-		rc: requestContext,
+		i:   i,
+		ls:  linkService,
+		rs:  redirectService,
+		lvs: linkVisitService,
+		qrs: qrService,
+		es:  exportService,
+		rc:  requestContext,
 	}, nil
 }
 
@@ -86,14 +131,94 @@ func (l *linkHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response, err := l.ls.CreateLink(r.Context(), userID, payload.DestinationURL, payload.Title, payload.CustomCode)
+	if payload.FallbackURL != nil && *payload.FallbackURL != "" {
+		if _, err := url.ParseRequestURI(*payload.FallbackURL); err != nil {
+			logger.Error("invalid fallback URL", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+	}
+
+	response, err := l.ls.CreateLink(r.Context(), userID, payload)
 	if err != nil {
+		if err == models.ErrEmailNotVerified {
+			logger.Warn("email not verified")
+			responses.Error(w, http.StatusForbidden, err)
+			return
+		}
+
 		if err == models.ErrCustomCodeAlreadyExists {
 			logger.Error("custom code already exists")
 			responses.NoContent(w, http.StatusConflict)
 			return
 		}
 
+		if err == models.ErrShortCodeExhausted {
+			logger.Error("short code space exhausted")
+			responses.NoContent(w, http.StatusServiceUnavailable)
+			return
+		}
+
+		if err == models.ErrDomainNotBelongToUser {
+			logger.Error("domain does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		if err == models.ErrInvalidDomain || err == models.ErrDomainNotFound || err == models.ErrDomainNotVerified {
+			logger.Error("invalid link domain", slog.String("error", err.Error()))
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrFolderNotFound || err == models.ErrFolderNotBelongToUser || err == models.ErrTagNotFound || err == models.ErrTagNotBelongToUser {
+			logger.Error("invalid link organization", slog.String("error", err.Error()))
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrInvalidShortCode || err == models.ErrReservedShortCode {
+			logger.Error("invalid custom code", slog.String("error", err.Error()))
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrInvalidRedirectType {
+			logger.Error("invalid redirect type")
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrInvalidLinkDestinations || err == models.ErrInvalidDestinationWeight || err == models.ErrInvalidDestinationLabel {
+			logger.Error("invalid link destinations", slog.String("error", err.Error()))
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrInvalidLinkURL {
+			logger.Error("invalid link URL")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		if err == models.ErrInvalidExpiration {
+			logger.Error("invalid expiration")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		if err == models.ErrInvalidMaxClicks {
+			logger.Error("invalid max clicks")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		if err == models.ErrInvalidActivationWindow {
+			logger.Error("invalid activation window")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
 		logger.Error("create link", slog.String("error", err.Error()))
 		responses.NoContent(w, http.StatusInternalServerError)
 		return
@@ -102,6 +227,98 @@ func (l *linkHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
 	responses.JSON(w, http.StatusCreated, response)
 }
 
+func (l *linkHandler) CreateLinksBatch(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link",
+		"method", "CreateLinksBatch",
+	)
+
+	var payload models.BatchLinkPayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := l.ls.CreateLinks(r.Context(), userID, payload.Links)
+	if err != nil {
+		writeBatchError(w, logger, err)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (l *linkHandler) ImportLinks(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link",
+		"method", "ImportLinks",
+	)
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, linkImportMaxBytes)
+	defer r.Body.Close()
+
+	var reader io.Reader = r.Body
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			logger.Error("read CSV file", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		reader = file
+	}
+
+	response, err := l.ls.ImportLinks(r.Context(), userID, reader)
+	if err != nil {
+		writeBatchError(w, logger, err)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func writeBatchError(w http.ResponseWriter, logger *slog.Logger, err error) {
+	if err == models.ErrEmptyLinkBatch || err == models.ErrInvalidLinkCSV {
+		logger.Error("invalid link batch", slog.String("error", err.Error()))
+		responses.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err == models.ErrEmailNotVerified {
+		logger.Warn("email not verified")
+		responses.Error(w, http.StatusForbidden, err)
+		return
+	}
+
+	if err == models.ErrLinkBatchTooLarge {
+		logger.Error("link batch too large")
+		responses.Error(w, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+
+	logger.Error("create links", slog.String("error", err.Error()))
+	responses.NoContent(w, http.StatusInternalServerError)
+}
+
 func (l *linkHandler) RedirectLink(w http.ResponseWriter, r *http.Request) {
 	logger := slog.With(
 		"handler", "link",
@@ -115,27 +332,138 @@ func (l *linkHandler) RedirectLink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ip := r.Header.Get("X-Forwarded-For")
-	if ip == "" {
-		ip = r.RemoteAddr
-	}
+	request := newRedirectRequest(r, shortCode)
 
-	userAgent := r.UserAgent()
+	if cookie, err := r.Cookie(linkAccessCookieName); err == nil {
+		request.AccessToken = cookie.Value
+	}
 
-	originalURL, err := l.rs.GetOriginalURLWithTracking(r.Context(), shortCode, userAgent, ip)
+	redirect, err := l.rs.GetOriginalURLWithTracking(r.Context(), request)
 	if err != nil {
-		if err == models.ErrLinkNotFound {
+		if errors.Is(err, models.ErrLinkNotFound) {
 			logger.Error("original URL not found")
 			responses.NoContent(w, http.StatusNotFound)
 			return
 		}
 
+		if errors.Is(err, models.ErrLinkPasswordRequired) {
+			responses.HTML(w, http.StatusOK, templates, "unlock.html", unlockPage{ShortCode: shortCode})
+			return
+		}
+
+		if errors.Is(err, models.ErrLinkNotActive) {
+			logger.Warn("link not active yet")
+			writeLinkNotActive(w, r)
+			return
+		}
+
+		if errors.Is(err, models.ErrLinkExpired) {
+			logger.Warn("link expired")
+			responses.NoContent(w, http.StatusGone)
+			return
+		}
+
+		if errors.Is(err, models.ErrLinkClickLimitReached) {
+			logger.Warn("link click limit reached")
+			responses.NoContent(w, http.StatusGone)
+			return
+		}
+
 		logger.Error("get original URL by short code", slog.String("error", err.Error()))
 		responses.NoContent(w, http.StatusInternalServerError)
 		return
 	}
 
-	http.Redirect(w, r, originalURL, http.StatusFound)
+	if redirect.StickyDestination {
+		setDestinationCookie(w, r, shortCode, redirect.DestinationID)
+	}
+
+	writeRedirect(w, r, redirect.DestinationURL, redirect.StatusCode, redirect.Cacheable)
+}
+
+func (l *linkHandler) UnlockLink(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link",
+		"method", "UnlockLink",
+	)
+
+	params := mux.Vars(r)
+	shortCode := params["shortCode"]
+	if shortCode == "" {
+		logger.Error("empty short code")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	var payload models.UnlockLinkPayload
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+			logger.Error("decode payload", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+		defer r.Body.Close()
+	} else {
+		payload.Password = r.PostFormValue("password")
+	}
+
+	request := newRedirectRequest(r, shortCode)
+
+	response, err := l.rs.UnlockLink(r.Context(), request, payload.Password)
+	if err != nil {
+		if errors.Is(err, models.ErrLinkNotFound) {
+			logger.Error("link not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, models.ErrTooManyUnlockAttempts) {
+			logger.Warn("too many unlock attempts")
+			w.Header().Set("Retry-After", strconv.Itoa(int(config.Env.UnlockLimit.Window.Seconds())))
+			responses.NoContent(w, http.StatusTooManyRequests)
+			return
+		}
+
+		if errors.Is(err, models.ErrInvalidLinkPassword) {
+			logger.Warn("invalid link password")
+			responses.HTML(w, http.StatusForbidden, templates, "unlock.html", unlockPage{ShortCode: shortCode, Invalid: true})
+			return
+		}
+
+		if errors.Is(err, models.ErrLinkNotActive) {
+			logger.Warn("link not active yet")
+			writeLinkNotActive(w, r)
+			return
+		}
+
+		if errors.Is(err, models.ErrLinkExpired) || errors.Is(err, models.ErrLinkClickLimitReached) {
+			logger.Warn("link unavailable", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusGone)
+			return
+		}
+
+		logger.Error("unlock link", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	if response.AccessToken != "" {
+		http.SetCookie(w, &http.Cookie{
+			Name:     linkAccessCookieName,
+			Value:    response.AccessToken,
+			Path:     "/" + shortCode,
+			Expires:  response.ExpiresAt,
+			HttpOnly: true,
+			Secure:   r.TLS != nil,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
+	if response.StickyDestination {
+		setDestinationCookie(w, r, shortCode, response.DestinationID)
+	}
+
+	writeRedirect(w, r, response.DestinationURL, response.StatusCode, false)
 }
 
 func (l *linkHandler) GetLinks(w http.ResponseWriter, r *http.Request) {
@@ -151,8 +479,21 @@ func (l *linkHandler) GetLinks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response, err := l.ls.GetLinksByUserID(r.Context(), userID)
+	query, err := parseLinkQuery(r)
+	if err != nil {
+		logger.Error("parse link query", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	response, err := l.ls.GetLinksByUserID(r.Context(), userID, query)
 	if err != nil {
+		if err == models.ErrInvalidLinkQuery || err == models.ErrInvalidLinkCursor {
+			logger.Error("invalid link query", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
 		logger.Error("get links by user ID", slog.String("error", err.Error()))
 		responses.NoContent(w, http.StatusInternalServerError)
 		return
@@ -184,7 +525,7 @@ func (l *linkHandler) GetLinkDetails(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response, err := l.ls.GetLinkDetails(r.Context(), userID, shortCode)
+	response, err := l.ls.GetLinkDetails(r.Context(), userID, linkDomain(r), shortCode)
 	if err != nil {
 		if err == models.ErrLinkNotFound {
 			logger.Error("link not found")
@@ -205,3 +546,601 @@ func (l *linkHandler) GetLinkDetails(w http.ResponseWriter, r *http.Request) {
 
 	responses.JSON(w, http.StatusOK, response)
 }
+
+func (l *linkHandler) UpdateLink(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link",
+		"method", "UpdateLink",
+	)
+
+	params := mux.Vars(r)
+	shortCode := params["shortCode"]
+	if shortCode == "" {
+		logger.Error("empty short code")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	var payload models.UpdateLinkPayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	if payload.DestinationURL != nil {
+		if _, err := url.ParseRequestURI(*payload.DestinationURL); err != nil {
+			logger.Error("invalid destination URL", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+	}
+
+	if payload.FallbackURL != nil && *payload.FallbackURL != "" {
+		if _, err := url.ParseRequestURI(*payload.FallbackURL); err != nil {
+			logger.Error("invalid fallback URL", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+	}
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := l.ls.UpdateLink(r.Context(), userID, linkDomain(r), shortCode, payload)
+	if err != nil {
+		if err == models.ErrLinkNotFound {
+			logger.Error("link not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrInvalidExpiration {
+			logger.Error("invalid expiration")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		if err == models.ErrInvalidMaxClicks {
+			logger.Error("invalid max clicks")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		if err == models.ErrInvalidActivationWindow {
+			logger.Error("invalid activation window")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		if err == models.ErrConflictingSchedule {
+			logger.Error("conflicting schedule update")
+			responses.Error(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err == models.ErrConflictingMaxClicks {
+			logger.Error("conflicting max clicks update")
+			responses.Error(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err == models.ErrLinkNotBelongToUser {
+			logger.Error("link does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		if err == models.ErrFolderNotFound || err == models.ErrFolderNotBelongToUser || err == models.ErrTagNotFound || err == models.ErrTagNotBelongToUser {
+			logger.Error("invalid link organization", slog.String("error", err.Error()))
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrInvalidRedirectType {
+			logger.Error("invalid redirect type")
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrInvalidLinkDestinations || err == models.ErrInvalidDestinationWeight || err == models.ErrInvalidDestinationLabel {
+			logger.Error("invalid link destinations", slog.String("error", err.Error()))
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		logger.Error("update link", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (l *linkHandler) DeleteLink(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link",
+		"method", "DeleteLink",
+	)
+
+	params := mux.Vars(r)
+	shortCode := params["shortCode"]
+	if shortCode == "" {
+		logger.Error("empty short code")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	if err := l.ls.DeleteLink(r.Context(), userID, linkDomain(r), shortCode); err != nil {
+		if err == models.ErrLinkNotFound {
+			logger.Error("link not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrLinkNotBelongToUser {
+			logger.Error("link does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		logger.Error("delete link", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.NoContent(w, http.StatusNoContent)
+}
+
+func newRedirectRequest(r *http.Request, shortCode string) models.RedirectRequest {
+	request := models.RedirectRequest{
+		Host:           r.Host,
+		ShortCode:      shortCode,
+		IPAddress:      clientIP(r),
+		UserAgent:      r.UserAgent(),
+		Referrer:       r.Referer(),
+		AcceptLanguage: r.Header.Get("Accept-Language"),
+		Query:          r.URL.Query(),
+	}
+
+	if config.Env.CountryHeader != "" && isTrustedProxy(r.RemoteAddr) {
+		request.Country = r.Header.Get(config.Env.CountryHeader)
+	}
+
+	if cookie, err := r.Cookie(linkDestinationCookieName); err == nil {
+		request.DestinationID = cookie.Value
+	}
+
+	return request
+}
+
+func setDestinationCookie(w http.ResponseWriter, r *http.Request, shortCode, destinationID string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     linkDestinationCookieName,
+		Value:    destinationID,
+		Path:     "/" + shortCode,
+		MaxAge:   int(linkDestinationCookieMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func writeRedirect(w http.ResponseWriter, r *http.Request, destinationURL string, statusCode int, cacheable bool) {
+	if cacheable && models.IsPermanentRedirect(statusCode) {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(config.Env.PermanentRedirectMaxAge.Seconds())))
+	} else {
+		w.Header().Set("Cache-Control", "private, no-store")
+	}
+
+	http.Redirect(w, r, destinationURL, statusCode)
+}
+
+func writeLinkNotActive(w http.ResponseWriter, r *http.Request) {
+	if config.Env.LinkNotActive.RedirectURL != "" {
+		http.Redirect(w, r, config.Env.LinkNotActive.RedirectURL, http.StatusFound)
+		return
+	}
+
+	statusCode := config.Env.LinkNotActive.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusNotFound
+	}
+
+	responses.NoContent(w, statusCode)
+}
+
+func (l *linkHandler) GetLinkStats(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link",
+		"method", "GetLinkStats",
+	)
+
+	params := mux.Vars(r)
+	shortCode := params["shortCode"]
+	if shortCode == "" {
+		logger.Error("empty short code")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	query, err := parseLinkStatsQuery(r)
+	if err != nil {
+		logger.Error("parse stats query", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	response, err := l.lvs.GetLinkStats(r.Context(), userID, linkDomain(r), shortCode, query)
+	if err != nil {
+		if err == models.ErrInvalidStatsQuery {
+			logger.Error("invalid stats query")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		if err == models.ErrLinkNotFound {
+			logger.Error("link not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrLinkNotBelongToUser {
+			logger.Error("link does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		logger.Error("get link stats", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (l *linkHandler) GetLinkQRCode(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link",
+		"method", "GetLinkQRCode",
+	)
+
+	params := mux.Vars(r)
+	shortCode := params["shortCode"]
+	if shortCode == "" {
+		logger.Error("empty short code")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	options, err := parseQROptions(r)
+	if err != nil {
+		logger.Error("parse QR options", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	qrCode, err := l.qrs.GenerateLinkQRCode(r.Context(), userID, linkDomain(r), shortCode, options)
+	if err != nil {
+		if err == models.ErrInvalidQROptions {
+			logger.Error("invalid QR options")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		if err == models.ErrLinkNotFound {
+			logger.Error("link not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrLinkNotBelongToUser {
+			logger.Error("link does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		logger.Error("generate QR code", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", shortCode+"."+string(options.Format)))
+	responses.Data(w, http.StatusOK, qrCode.ContentType, qrCode.Data)
+}
+
+func (l *linkHandler) ExportLinks(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link",
+		"method", "ExportLinks",
+	)
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	format, err := models.ParseExportFormat(strings.ToLower(r.URL.Query().Get("format")))
+	if err != nil {
+		logger.Error("invalid export format")
+		responses.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	query, err := parseLinkQuery(r)
+	if err != nil {
+		logger.Error("parse link query", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	writer := newExportResponseWriter(w, format, "links")
+
+	if err := l.es.ExportLinks(r.Context(), userID, query, format, writer); err != nil {
+		if writer.started {
+			logger.Error("export interrupted", slog.String("error", err.Error()))
+			return
+		}
+
+		if err == models.ErrInvalidExportQuery {
+			logger.Error("invalid export query")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		logger.Error("export links", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+}
+
+func (l *linkHandler) ExportLinkVisits(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link",
+		"method", "ExportLinkVisits",
+	)
+
+	params := mux.Vars(r)
+	shortCode := params["shortCode"]
+	if shortCode == "" {
+		logger.Error("empty short code")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	format, err := models.ParseExportFormat(strings.ToLower(r.URL.Query().Get("format")))
+	if err != nil {
+		logger.Error("invalid export format")
+		responses.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	query, err := parseVisitExportQuery(r)
+	if err != nil {
+		logger.Error("parse export query", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	writer := newExportResponseWriter(w, format, shortCode+"-visits")
+
+	if err := l.es.ExportLinkVisits(r.Context(), userID, linkDomain(r), shortCode, query, format, writer); err != nil {
+		if writer.started {
+			logger.Error("export interrupted", slog.String("error", err.Error()))
+			return
+		}
+
+		if err == models.ErrInvalidExportQuery {
+			logger.Error("invalid export query")
+			responses.NoContent(w, http.StatusBadRequest)
+			return
+		}
+
+		if err == models.ErrLinkNotFound {
+			logger.Error("link not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrLinkNotBelongToUser {
+			logger.Error("link does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		logger.Error("export link visits", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+}
+
+type exportResponseWriter struct {
+	w        http.ResponseWriter
+	format   models.ExportFormat
+	filename string
+	started  bool
+}
+
+func newExportResponseWriter(w http.ResponseWriter, format models.ExportFormat, filename string) *exportResponseWriter {
+	return &exportResponseWriter{
+		w:        w,
+		format:   format,
+		filename: filename,
+	}
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.started = true
+		e.w.Header().Set("Content-Type", e.format.ContentType())
+		e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", e.filename+"."+string(e.format)))
+		e.w.Header().Set("Cache-Control", "no-store")
+		e.w.WriteHeader(http.StatusOK)
+	}
+
+	return e.w.Write(p)
+}
+
+// linkDomain reads the domain a managed link is served on; links on the
+// default domain are addressed without it.
+func linkDomain(r *http.Request) string {
+	return strings.ToLower(strings.TrimSpace(r.URL.Query().Get("domain")))
+}
+
+func parseVisitExportQuery(r *http.Request) (models.VisitExportQuery, error) {
+	values := r.URL.Query()
+
+	var query models.VisitExportQuery
+
+	if from := values.Get("from"); from != "" {
+		parsedFrom, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return query, fmt.Errorf("parse from: %w", err)
+		}
+		query.From = &parsedFrom
+	}
+
+	if to := values.Get("to"); to != "" {
+		parsedTo, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return query, fmt.Errorf("parse to: %w", err)
+		}
+		query.To = &parsedTo
+	}
+
+	return query, nil
+}
+
+func parseQROptions(r *http.Request) (models.QROptions, error) {
+	values := r.URL.Query()
+
+	options := models.QROptions{
+		Format:     models.QRFormat(strings.ToLower(values.Get("format"))),
+		Level:      models.QRLevel(values.Get("level")),
+		Foreground: values.Get("fg"),
+		Background: values.Get("bg"),
+	}
+
+	if options.Format == "" {
+		options.Format = models.QRFormatPNG
+	}
+
+	if size := values.Get("size"); size != "" {
+		parsedSize, err := strconv.Atoi(size)
+		if err != nil {
+			return options, fmt.Errorf("parse size: %w", err)
+		}
+		options.Size = parsedSize
+	}
+
+	if margin := values.Get("margin"); margin != "" {
+		parsedMargin, err := strconv.Atoi(margin)
+		if err != nil {
+			return options, fmt.Errorf("parse margin: %w", err)
+		}
+		options.Margin = &parsedMargin
+	}
+
+	return options, nil
+}
+
+func parseLinkStatsQuery(r *http.Request) (models.LinkStatsQuery, error) {
+	values := r.URL.Query()
+
+	query := models.LinkStatsQuery{
+		Bucket: models.StatsBucket(values.Get("bucket")),
+	}
+
+	if from := values.Get("from"); from != "" {
+		parsedFrom, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return query, fmt.Errorf("parse from: %w", err)
+		}
+		query.From = parsedFrom
+	}
+
+	if to := values.Get("to"); to != "" {
+		parsedTo, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return query, fmt.Errorf("parse to: %w", err)
+		}
+		query.To = parsedTo
+	}
+
+	return query, nil
+}
+
+func parseLinkQuery(r *http.Request) (models.LinkQuery, error) {
+	values := r.URL.Query()
+
+	query := models.LinkQuery{
+		Cursor: values.Get("cursor"),
+		Sort:   models.LinkSortField(values.Get("sort")),
+		Order:  models.SortOrder(values.Get("order")),
+		Domain: values.Get("domain"),
+		Search: values.Get("q"),
+		Tag:    values.Get("tag"),
+		Folder: values.Get("folder"),
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		parsedLimit, err := strconv.Atoi(limit)
+		if err != nil {
+			return query, fmt.Errorf("parse limit: %w", err)
+		}
+		query.Limit = parsedLimit
+	}
+
+	if from := values.Get("from"); from != "" {
+		parsedFrom, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return query, fmt.Errorf("parse from: %w", err)
+		}
+		query.From = &parsedFrom
+	}
+
+	if to := values.Get("to"); to != "" {
+		parsedTo, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return query, fmt.Errorf("parse to: %w", err)
+		}
+		query.To = &parsedTo
+	}
+
+	return query, nil
+}
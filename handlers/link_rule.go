@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/pkgs/requestcontext"
+	"github.com/g-villarinho/link-fizz-api/responses"
+	"github.com/g-villarinho/link-fizz-api/services"
+	"github.com/gorilla/mux"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type LinkRuleHandler interface {
+	GetLinkRules(w http.ResponseWriter, r *http.Request)
+	CreateLinkRule(w http.ResponseWriter, r *http.Request)
+	UpdateLinkRule(w http.ResponseWriter, r *http.Request)
+	DeleteLinkRule(w http.ResponseWriter, r *http.Request)
+}
+
+type linkRuleHandler struct {
+	i   *di.Injector
+	lrs services.LinkRuleService
+	rc  requestcontext.RequestContext
+}
+
+func NewLinkRuleHandler(i *di.Injector) (LinkRuleHandler, error) {
+	linkRuleService, err := di.Invoke[services.LinkRuleService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.LinkRuleService: %w", err)
+	}
+
+	requestContext, err := di.Invoke[requestcontext.RequestContext](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke requestcontext.RequestContext: %w", err)
+	}
+
+	return &linkRuleHandler{
+		i:   i,
+		lrs: linkRuleService,
+		rc:  requestContext,
+	}, nil
+}
+
+func (l *linkRuleHandler) GetLinkRules(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link_rule",
+		"method", "GetLinkRules",
+	)
+
+	shortCode := mux.Vars(r)["shortCode"]
+	if shortCode == "" {
+		logger.Error("empty short code")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := l.lrs.GetLinkRules(r.Context(), userID, linkDomain(r), shortCode)
+	if err != nil {
+		if err == models.ErrLinkNotFound {
+			logger.Error("link not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrLinkNotBelongToUser {
+			logger.Error("link does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		logger.Error("get link rules", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (l *linkRuleHandler) CreateLinkRule(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link_rule",
+		"method", "CreateLinkRule",
+	)
+
+	shortCode := mux.Vars(r)["shortCode"]
+	if shortCode == "" {
+		logger.Error("empty short code")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	var payload models.LinkRulePayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := l.lrs.CreateLinkRule(r.Context(), userID, linkDomain(r), shortCode, payload)
+	if err != nil {
+		writeLinkRuleError(w, logger, "create link rule", err)
+		return
+	}
+
+	responses.JSON(w, http.StatusCreated, response)
+}
+
+func (l *linkRuleHandler) UpdateLinkRule(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link_rule",
+		"method", "UpdateLinkRule",
+	)
+
+	params := mux.Vars(r)
+	shortCode, ruleID := params["shortCode"], params["id"]
+	if shortCode == "" || ruleID == "" {
+		logger.Error("empty short code or rule ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	var payload models.LinkRulePayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := l.lrs.UpdateLinkRule(r.Context(), userID, linkDomain(r), shortCode, ruleID, payload)
+	if err != nil {
+		writeLinkRuleError(w, logger, "update link rule", err)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (l *linkRuleHandler) DeleteLinkRule(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "link_rule",
+		"method", "DeleteLinkRule",
+	)
+
+	params := mux.Vars(r)
+	shortCode, ruleID := params["shortCode"], params["id"]
+	if shortCode == "" || ruleID == "" {
+		logger.Error("empty short code or rule ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := l.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	if err := l.lrs.DeleteLinkRule(r.Context(), userID, linkDomain(r), shortCode, ruleID); err != nil {
+		writeLinkRuleError(w, logger, "delete link rule", err)
+		return
+	}
+
+	responses.NoContent(w, http.StatusNoContent)
+}
+
+func writeLinkRuleError(w http.ResponseWriter, logger *slog.Logger, action string, err error) {
+	switch err {
+	case models.ErrLinkNotFound, models.ErrLinkRuleNotFound:
+		logger.Error("link or rule not found", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusNotFound)
+	case models.ErrLinkNotBelongToUser:
+		logger.Error("link does not belong to user")
+		responses.NoContent(w, http.StatusForbidden)
+	case models.ErrInvalidLinkURL,
+		models.ErrInvalidRuleConditions,
+		models.ErrInvalidRuleConditionType,
+		models.ErrInvalidRuleConditionValue,
+		models.ErrInvalidRulePosition,
+		models.ErrLinkRuleLimitReached:
+		logger.Error("invalid link rule", slog.String("error", err.Error()))
+		responses.Error(w, http.StatusUnprocessableEntity, err)
+	default:
+		logger.Error(action, slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+	}
+}
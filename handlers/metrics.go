@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/responses"
+	"github.com/g-villarinho/link-fizz-api/services"
+)
+
+type MetricsHandler interface {
+	GetVisitRecorderStats(w http.ResponseWriter, r *http.Request)
+}
+
+type metricsHandler struct {
+	i  *di.Injector
+	vr services.VisitRecorder
+}
+
+func NewMetricsHandler(i *di.Injector) (MetricsHandler, error) {
+	visitRecorder, err := di.Invoke[services.VisitRecorder](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.VisitRecorder: %w", err)
+	}
+
+	return &metricsHandler{
+		i:  i,
+		vr: visitRecorder,
+	}, nil
+}
+
+func (m *metricsHandler) GetVisitRecorderStats(w http.ResponseWriter, r *http.Request) {
+	responses.JSON(w, http.StatusOK, m.vr.Stats())
+}
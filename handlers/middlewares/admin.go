@@ -0,0 +1,42 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/responses"
+)
+
+type AdminMiddleware interface {
+	Authenticate(next http.Handler) http.Handler
+}
+
+type adminMiddleware struct {
+	i     *di.Injector
+	token string
+}
+
+func NewAdminMiddleware(i *di.Injector) (AdminMiddleware, error) {
+	return &adminMiddleware{
+		i:     i,
+		token: config.Env.AdminToken,
+	}, nil
+}
+
+// Authenticate requires the ADMIN_TOKEN bearer secret. Without a configured secret every request is rejected.
+func (a *adminMiddleware) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || a.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+			responses.JSON(w, http.StatusUnauthorized, errorResponse{
+				Code: "UNAUTHORIZED",
+			})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -22,6 +22,7 @@ type authMiddleware struct {
 	i  *di.Injector
 	ts services.TokenService
 	ls services.LogoutService
+	ss services.SessionService
 	rc requestcontext.RequestContext
 }
 
@@ -36,6 +37,11 @@ func NewAuthMiddleware(i *di.Injector) (AuthMiddleware, error) {
 		return nil, err
 	}
 
+	sessionService, err := di.Invoke[services.SessionService](i)
+	if err != nil {
+		return nil, err
+	}
+
 	requestContext, err := di.Invoke[requestcontext.RequestContext](i)
 	if err != nil {
 		return nil, err
@@ -45,6 +51,7 @@ func NewAuthMiddleware(i *di.Injector) (AuthMiddleware, error) {
 		i:  i,
 		ts: tokenService,
 		ls: logoutService,
+		ss: sessionService,
 		rc: requestContext,
 	}, nil
 }
@@ -90,6 +97,13 @@ func (a *authMiddleware) Authenticate(next http.Handler) http.Handler {
 			return
 		}
 
+		if err := a.ss.ValidateSession(r.Context(), claims.Sub, claims.Sid); err != nil {
+			responses.JSON(w, http.StatusUnauthorized, errorResponse{
+				Code: "UNAUTHORIZED",
+			})
+			return
+		}
+
 		ctx := a.rc.SetUserID(r.Context(), claims.Sub)
 		ctx = a.rc.SetToken(ctx, token)
 		ctx = a.rc.SetSessionID(ctx, claims.Sid)
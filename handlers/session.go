@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/pkgs/requestcontext"
+	"github.com/g-villarinho/link-fizz-api/responses"
+	"github.com/g-villarinho/link-fizz-api/services"
+	"github.com/gorilla/mux"
+)
+
+type SessionHandler interface {
+	GetSessions(w http.ResponseWriter, r *http.Request)
+	RevokeSession(w http.ResponseWriter, r *http.Request)
+	RevokeOtherSessions(w http.ResponseWriter, r *http.Request)
+}
+
+type sessionHandler struct {
+	i  *di.Injector
+	ss services.SessionService
+	rc requestcontext.RequestContext
+}
+
+func NewSessionHandler(i *di.Injector) (SessionHandler, error) {
+	sessionService, err := di.Invoke[services.SessionService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.SessionService: %w", err)
+	}
+
+	requestContext, err := di.Invoke[requestcontext.RequestContext](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke requestcontext.RequestContext: %w", err)
+	}
+
+	return &sessionHandler{
+		i:  i,
+		ss: sessionService,
+		rc: requestContext,
+	}, nil
+}
+
+func (s *sessionHandler) GetSessions(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "session",
+		"method", "GetSessions",
+	)
+
+	userID, found := s.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	sessionID, found := s.rc.GetSessionID(r.Context())
+	if !found {
+		logger.Error("session ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := s.ss.GetUserSessions(r.Context(), userID, sessionID)
+	if err != nil {
+		logger.Error("get user sessions", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (s *sessionHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "session",
+		"method", "RevokeSession",
+	)
+
+	id := mux.Vars(r)["id"]
+	if id == "" {
+		logger.Error("empty session ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := s.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.ss.RevokeUserSession(r.Context(), userID, id); err != nil {
+		if err == models.ErrSessionNotFound {
+			logger.Error("session not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		logger.Error("revoke user session", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.NoContent(w, http.StatusNoContent)
+}
+
+func (s *sessionHandler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "session",
+		"method", "RevokeOtherSessions",
+	)
+
+	userID, found := s.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	sessionID, found := s.rc.GetSessionID(r.Context())
+	if !found {
+		logger.Error("session ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := s.ss.RevokeOtherSessions(r.Context(), userID, sessionID)
+	if err != nil {
+		logger.Error("revoke other sessions", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/pkgs/requestcontext"
+	"github.com/g-villarinho/link-fizz-api/responses"
+	"github.com/g-villarinho/link-fizz-api/services"
+	"github.com/gorilla/mux"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type TagHandler interface {
+	CreateTag(w http.ResponseWriter, r *http.Request)
+	GetTags(w http.ResponseWriter, r *http.Request)
+	UpdateTag(w http.ResponseWriter, r *http.Request)
+	DeleteTag(w http.ResponseWriter, r *http.Request)
+}
+
+type tagHandler struct {
+	i  *di.Injector
+	ts services.TagService
+	rc requestcontext.RequestContext
+}
+
+func NewTagHandler(i *di.Injector) (TagHandler, error) {
+	tagService, err := di.Invoke[services.TagService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.TagService: %w", err)
+	}
+
+	requestContext, err := di.Invoke[requestcontext.RequestContext](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke requestcontext.RequestContext: %w", err)
+	}
+
+	return &tagHandler{
+		i:  i,
+		ts: tagService,
+		rc: requestContext,
+	}, nil
+}
+
+func (t *tagHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "tag",
+		"method", "CreateTag",
+	)
+
+	var payload models.TagPayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	userID, found := t.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := t.ts.CreateTag(r.Context(), userID, payload)
+	if err != nil {
+		if err == models.ErrInvalidTagName {
+			logger.Error("invalid tag name")
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrTagAlreadyExists {
+			logger.Error("tag already exists")
+			responses.NoContent(w, http.StatusConflict)
+			return
+		}
+
+		logger.Error("create tag", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusCreated, response)
+}
+
+func (t *tagHandler) GetTags(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "tag",
+		"method", "GetTags",
+	)
+
+	userID, found := t.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := t.ts.GetTags(r.Context(), userID)
+	if err != nil {
+		logger.Error("get tags", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (t *tagHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "tag",
+		"method", "UpdateTag",
+	)
+
+	tagID := mux.Vars(r)["id"]
+	if tagID == "" {
+		logger.Error("empty tag ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	var payload models.TagPayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	userID, found := t.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	response, err := t.ts.UpdateTag(r.Context(), userID, tagID, payload)
+	if err != nil {
+		if err == models.ErrInvalidTagName {
+			logger.Error("invalid tag name")
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if err == models.ErrTagNotFound {
+			logger.Error("tag not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrTagNotBelongToUser {
+			logger.Error("tag does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		if err == models.ErrTagAlreadyExists {
+			logger.Error("tag already exists")
+			responses.NoContent(w, http.StatusConflict)
+			return
+		}
+
+		logger.Error("update tag", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, response)
+}
+
+func (t *tagHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "tag",
+		"method", "DeleteTag",
+	)
+
+	tagID := mux.Vars(r)["id"]
+	if tagID == "" {
+		logger.Error("empty tag ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, found := t.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	if err := t.ts.DeleteTag(r.Context(), userID, tagID); err != nil {
+		if err == models.ErrTagNotFound {
+			logger.Error("tag not found")
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		if err == models.ErrTagNotBelongToUser {
+			logger.Error("tag does not belong to user")
+			responses.NoContent(w, http.StatusForbidden)
+			return
+		}
+
+		logger.Error("delete tag", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.NoContent(w, http.StatusNoContent)
+}
@@ -0,0 +1,16 @@
+package handlers
+
+import (
+	"embed"
+	"html/template"
+)
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+var templates = template.Must(template.ParseFS(templatesFS, "templates/*.html"))
+
+type unlockPage struct {
+	ShortCode string
+	Invalid   bool
+}
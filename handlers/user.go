@@ -18,6 +18,7 @@ type UserHandler interface {
 	UpdateUser(w http.ResponseWriter, r *http.Request)
 	DeleteUser(w http.ResponseWriter, r *http.Request)
 	UpdatePassword(w http.ResponseWriter, r *http.Request)
+	UpdatePreferences(w http.ResponseWriter, r *http.Request)
 }
 
 type userHandler struct {
@@ -154,3 +155,46 @@ func (u *userHandler) UpdatePassword(w http.ResponseWriter, r *http.Request) {
 
 	responses.NoContent(w, http.StatusNoContent)
 }
+
+func (u *userHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "user",
+		"method", "UpdatePreferences",
+	)
+
+	var payload models.UpdatePreferencesPayload
+	if err := jsoniter.NewDecoder(r.Body).Decode(&payload); err != nil {
+		logger.Error("decode payload", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	userID, found := u.rc.GetUserID(r.Context())
+	if !found {
+		logger.Error("user ID not found in context")
+		responses.NoContent(w, http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := u.ur.UpdatePreferences(r.Context(), userID, payload)
+	if err != nil {
+		if err == models.ErrUserNotFound {
+			logger.Error("user not found", slog.String("error", err.Error()))
+			responses.NoContent(w, http.StatusUnauthorized)
+			return
+		}
+
+		if err == models.ErrInvalidRedirectType {
+			logger.Error("invalid redirect type")
+			responses.Error(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		logger.Error("update preferences", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	responses.JSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/responses"
+	"github.com/g-villarinho/link-fizz-api/services"
+	"github.com/gorilla/mux"
+)
+
+type UserStatusHandler interface {
+	DisableUser(w http.ResponseWriter, r *http.Request)
+	EnableUser(w http.ResponseWriter, r *http.Request)
+}
+
+type userStatusHandler struct {
+	i  *di.Injector
+	us services.UserService
+}
+
+func NewUserStatusHandler(i *di.Injector) (UserStatusHandler, error) {
+	userService, err := di.Invoke[services.UserService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.UserService: %w", err)
+	}
+
+	return &userStatusHandler{
+		i:  i,
+		us: userService,
+	}, nil
+}
+
+func (u *userStatusHandler) DisableUser(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "user_status",
+		"method", "DisableUser",
+	)
+
+	u.setStatus(w, r, logger, u.us.DisableUser)
+}
+
+func (u *userStatusHandler) EnableUser(w http.ResponseWriter, r *http.Request) {
+	logger := slog.With(
+		"handler", "user_status",
+		"method", "EnableUser",
+	)
+
+	u.setStatus(w, r, logger, u.us.EnableUser)
+}
+
+func (u *userStatusHandler) setStatus(w http.ResponseWriter, r *http.Request, logger *slog.Logger, update func(ctx context.Context, ID string) error) {
+	userID := mux.Vars(r)["id"]
+	if userID == "" {
+		logger.Error("empty user ID")
+		responses.NoContent(w, http.StatusBadRequest)
+		return
+	}
+
+	if err := update(r.Context(), userID); err != nil {
+		if err == models.ErrUserNotFound {
+			logger.Error("user not found", slog.String("user_id", userID))
+			responses.NoContent(w, http.StatusNotFound)
+			return
+		}
+
+		logger.Error("update user status", slog.String("error", err.Error()))
+		responses.NoContent(w, http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("user status updated", slog.String("user_id", userID))
+	responses.NoContent(w, http.StatusNoContent)
+}
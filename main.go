@@ -13,6 +13,7 @@ import (
 	"github.com/g-villarinho/link-fizz-api/config"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
 	"github.com/g-villarinho/link-fizz-api/router"
+	"github.com/g-villarinho/link-fizz-api/services"
 )
 
 func main() {
@@ -30,6 +31,20 @@ func main() {
 		Handler: mux,
 	}
 
+	var internalServer *http.Server
+	if config.Env.InternalAddr != "" {
+		internalServer = &http.Server{
+			Addr:    config.Env.InternalAddr,
+			Handler: router.SetupInternalRoutes(i),
+		}
+
+		go func() {
+			if err := internalServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("❌ Failed to start internal server: %v", err)
+			}
+		}()
+	}
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 
@@ -49,9 +64,34 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	if internalServer != nil {
+		if err := internalServer.Shutdown(ctx); err != nil {
+			log.Printf("⚠️ Error during internal server shutdown: %v", err)
+		}
+	}
+
 	if err := server.Shutdown(ctx); err != nil {
 		log.Printf("⚠️ Error during server shutdown: %v", err)
 	}
+
+	// The queue gets its own deadline so a slow HTTP drain cannot eat into it.
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), config.Env.VisitQueue.DrainTimeout)
+	defer drainCancel()
+
+	visitRecorder, err := di.Invoke[services.VisitRecorder](i)
+	if err != nil {
+		log.Printf("⚠️ Error resolving visit recorder: %v", err)
+	} else if err := visitRecorder.Shutdown(drainCtx); err != nil {
+		log.Printf("⚠️ Error draining visit queue: %v", err)
+	}
+
+	geoIPLocator, err := di.Invoke[services.GeoIPLocator](i)
+	if err != nil {
+		log.Printf("⚠️ Error resolving geoip locator: %v", err)
+	} else if err := geoIPLocator.Close(); err != nil {
+		log.Printf("⚠️ Error closing geoip locator: %v", err)
+	}
+
 	db.Close()
 	log.Println("\n✅ Server and database connection shut down properly.")
 }
@@ -89,6 +89,40 @@ func (_c *AuthHandlerMock_Logout_Call) RunAndReturn(run func(http.ResponseWriter
 	return _c
 }
 
+// RefreshToken provides a mock function with given fields: w, r
+func (_m *AuthHandlerMock) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// AuthHandlerMock_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
+type AuthHandlerMock_RefreshToken_Call struct {
+	*mock.Call
+}
+
+// RefreshToken is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *AuthHandlerMock_Expecter) RefreshToken(w interface{}, r interface{}) *AuthHandlerMock_RefreshToken_Call {
+	return &AuthHandlerMock_RefreshToken_Call{Call: _e.mock.On("RefreshToken", w, r)}
+}
+
+func (_c *AuthHandlerMock_RefreshToken_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *AuthHandlerMock_RefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *AuthHandlerMock_RefreshToken_Call) Return() *AuthHandlerMock_RefreshToken_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AuthHandlerMock_RefreshToken_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *AuthHandlerMock_RefreshToken_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Register provides a mock function with given fields: w, r
 func (_m *AuthHandlerMock) Register(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
@@ -133,6 +133,67 @@ func (_c *AuthServiceMock_Logout_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// RefreshToken provides a mock function with given fields: ctx, refreshToken, ipAdress, userAgent
+func (_m *AuthServiceMock) RefreshToken(ctx context.Context, refreshToken string, ipAdress string, userAgent string) (*models.LoginResponse, error) {
+	ret := _m.Called(ctx, refreshToken, ipAdress, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshToken")
+	}
+
+	var r0 *models.LoginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.LoginResponse, error)); ok {
+		return rf(ctx, refreshToken, ipAdress, userAgent)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.LoginResponse); ok {
+		r0 = rf(ctx, refreshToken, ipAdress, userAgent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, refreshToken, ipAdress, userAgent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
+type AuthServiceMock_RefreshToken_Call struct {
+	*mock.Call
+}
+
+// RefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+//   - ipAdress string
+//   - userAgent string
+func (_e *AuthServiceMock_Expecter) RefreshToken(ctx interface{}, refreshToken interface{}, ipAdress interface{}, userAgent interface{}) *AuthServiceMock_RefreshToken_Call {
+	return &AuthServiceMock_RefreshToken_Call{Call: _e.mock.On("RefreshToken", ctx, refreshToken, ipAdress, userAgent)}
+}
+
+func (_c *AuthServiceMock_RefreshToken_Call) Run(run func(ctx context.Context, refreshToken string, ipAdress string, userAgent string)) *AuthServiceMock_RefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_RefreshToken_Call) Return(_a0 *models.LoginResponse, _a1 error) *AuthServiceMock_RefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_RefreshToken_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.LoginResponse, error)) *AuthServiceMock_RefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields: ctx, name, email, password, ipAdress, userAgent
 func (_m *AuthServiceMock) Register(ctx context.Context, name string, email string, password string, ipAdress string, userAgent string) (*models.LoginResponse, error) {
 	ret := _m.Called(ctx, name, email, password, ipAdress, userAgent)
@@ -55,36 +55,308 @@ func (_c *LinkHandlerMock_CreateLink_Call) RunAndReturn(run func(http.ResponseWr
 	return _c
 }
 
-// GetShortURLs provides a mock function with given fields: w, r
-func (_m *LinkHandlerMock) GetShortURLs(w http.ResponseWriter, r *http.Request) {
+// CreateLinksBatch provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) CreateLinksBatch(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
 }
 
-// LinkHandlerMock_GetShortURLs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShortURLs'
-type LinkHandlerMock_GetShortURLs_Call struct {
+// LinkHandlerMock_CreateLinksBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLinksBatch'
+type LinkHandlerMock_CreateLinksBatch_Call struct {
 	*mock.Call
 }
 
-// GetShortURLs is a helper method to define mock.On call
+// CreateLinksBatch is a helper method to define mock.On call
 //   - w http.ResponseWriter
 //   - r *http.Request
-func (_e *LinkHandlerMock_Expecter) GetShortURLs(w interface{}, r interface{}) *LinkHandlerMock_GetShortURLs_Call {
-	return &LinkHandlerMock_GetShortURLs_Call{Call: _e.mock.On("GetShortURLs", w, r)}
+func (_e *LinkHandlerMock_Expecter) CreateLinksBatch(w interface{}, r interface{}) *LinkHandlerMock_CreateLinksBatch_Call {
+	return &LinkHandlerMock_CreateLinksBatch_Call{Call: _e.mock.On("CreateLinksBatch", w, r)}
 }
 
-func (_c *LinkHandlerMock_GetShortURLs_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_GetShortURLs_Call {
+func (_c *LinkHandlerMock_CreateLinksBatch_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_CreateLinksBatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *LinkHandlerMock_GetShortURLs_Call) Return() *LinkHandlerMock_GetShortURLs_Call {
+func (_c *LinkHandlerMock_CreateLinksBatch_Call) Return() *LinkHandlerMock_CreateLinksBatch_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *LinkHandlerMock_GetShortURLs_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_GetShortURLs_Call {
+func (_c *LinkHandlerMock_CreateLinksBatch_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_CreateLinksBatch_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteLink provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) DeleteLink(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_DeleteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLink'
+type LinkHandlerMock_DeleteLink_Call struct {
+	*mock.Call
+}
+
+// DeleteLink is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) DeleteLink(w interface{}, r interface{}) *LinkHandlerMock_DeleteLink_Call {
+	return &LinkHandlerMock_DeleteLink_Call{Call: _e.mock.On("DeleteLink", w, r)}
+}
+
+func (_c *LinkHandlerMock_DeleteLink_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_DeleteLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_DeleteLink_Call) Return() *LinkHandlerMock_DeleteLink_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_DeleteLink_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_DeleteLink_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ExportLinkVisits provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) ExportLinkVisits(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_ExportLinkVisits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportLinkVisits'
+type LinkHandlerMock_ExportLinkVisits_Call struct {
+	*mock.Call
+}
+
+// ExportLinkVisits is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) ExportLinkVisits(w interface{}, r interface{}) *LinkHandlerMock_ExportLinkVisits_Call {
+	return &LinkHandlerMock_ExportLinkVisits_Call{Call: _e.mock.On("ExportLinkVisits", w, r)}
+}
+
+func (_c *LinkHandlerMock_ExportLinkVisits_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_ExportLinkVisits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_ExportLinkVisits_Call) Return() *LinkHandlerMock_ExportLinkVisits_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_ExportLinkVisits_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_ExportLinkVisits_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ExportLinks provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) ExportLinks(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_ExportLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportLinks'
+type LinkHandlerMock_ExportLinks_Call struct {
+	*mock.Call
+}
+
+// ExportLinks is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) ExportLinks(w interface{}, r interface{}) *LinkHandlerMock_ExportLinks_Call {
+	return &LinkHandlerMock_ExportLinks_Call{Call: _e.mock.On("ExportLinks", w, r)}
+}
+
+func (_c *LinkHandlerMock_ExportLinks_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_ExportLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_ExportLinks_Call) Return() *LinkHandlerMock_ExportLinks_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_ExportLinks_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_ExportLinks_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetLinkDetails provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) GetLinkDetails(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_GetLinkDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinkDetails'
+type LinkHandlerMock_GetLinkDetails_Call struct {
+	*mock.Call
+}
+
+// GetLinkDetails is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) GetLinkDetails(w interface{}, r interface{}) *LinkHandlerMock_GetLinkDetails_Call {
+	return &LinkHandlerMock_GetLinkDetails_Call{Call: _e.mock.On("GetLinkDetails", w, r)}
+}
+
+func (_c *LinkHandlerMock_GetLinkDetails_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_GetLinkDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_GetLinkDetails_Call) Return() *LinkHandlerMock_GetLinkDetails_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_GetLinkDetails_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_GetLinkDetails_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetLinkQRCode provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) GetLinkQRCode(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_GetLinkQRCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinkQRCode'
+type LinkHandlerMock_GetLinkQRCode_Call struct {
+	*mock.Call
+}
+
+// GetLinkQRCode is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) GetLinkQRCode(w interface{}, r interface{}) *LinkHandlerMock_GetLinkQRCode_Call {
+	return &LinkHandlerMock_GetLinkQRCode_Call{Call: _e.mock.On("GetLinkQRCode", w, r)}
+}
+
+func (_c *LinkHandlerMock_GetLinkQRCode_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_GetLinkQRCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_GetLinkQRCode_Call) Return() *LinkHandlerMock_GetLinkQRCode_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_GetLinkQRCode_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_GetLinkQRCode_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetLinkStats provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) GetLinkStats(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_GetLinkStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinkStats'
+type LinkHandlerMock_GetLinkStats_Call struct {
+	*mock.Call
+}
+
+// GetLinkStats is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) GetLinkStats(w interface{}, r interface{}) *LinkHandlerMock_GetLinkStats_Call {
+	return &LinkHandlerMock_GetLinkStats_Call{Call: _e.mock.On("GetLinkStats", w, r)}
+}
+
+func (_c *LinkHandlerMock_GetLinkStats_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_GetLinkStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_GetLinkStats_Call) Return() *LinkHandlerMock_GetLinkStats_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_GetLinkStats_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_GetLinkStats_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetLinks provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) GetLinks(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_GetLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinks'
+type LinkHandlerMock_GetLinks_Call struct {
+	*mock.Call
+}
+
+// GetLinks is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) GetLinks(w interface{}, r interface{}) *LinkHandlerMock_GetLinks_Call {
+	return &LinkHandlerMock_GetLinks_Call{Call: _e.mock.On("GetLinks", w, r)}
+}
+
+func (_c *LinkHandlerMock_GetLinks_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_GetLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_GetLinks_Call) Return() *LinkHandlerMock_GetLinks_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_GetLinks_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_GetLinks_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ImportLinks provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) ImportLinks(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_ImportLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportLinks'
+type LinkHandlerMock_ImportLinks_Call struct {
+	*mock.Call
+}
+
+// ImportLinks is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) ImportLinks(w interface{}, r interface{}) *LinkHandlerMock_ImportLinks_Call {
+	return &LinkHandlerMock_ImportLinks_Call{Call: _e.mock.On("ImportLinks", w, r)}
+}
+
+func (_c *LinkHandlerMock_ImportLinks_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_ImportLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_ImportLinks_Call) Return() *LinkHandlerMock_ImportLinks_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_ImportLinks_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_ImportLinks_Call {
 	_c.Run(run)
 	return _c
 }
@@ -123,6 +395,74 @@ func (_c *LinkHandlerMock_RedirectLink_Call) RunAndReturn(run func(http.Response
 	return _c
 }
 
+// UnlockLink provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) UnlockLink(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_UnlockLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockLink'
+type LinkHandlerMock_UnlockLink_Call struct {
+	*mock.Call
+}
+
+// UnlockLink is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) UnlockLink(w interface{}, r interface{}) *LinkHandlerMock_UnlockLink_Call {
+	return &LinkHandlerMock_UnlockLink_Call{Call: _e.mock.On("UnlockLink", w, r)}
+}
+
+func (_c *LinkHandlerMock_UnlockLink_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_UnlockLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_UnlockLink_Call) Return() *LinkHandlerMock_UnlockLink_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_UnlockLink_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_UnlockLink_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateLink provides a mock function with given fields: w, r
+func (_m *LinkHandlerMock) UpdateLink(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// LinkHandlerMock_UpdateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLink'
+type LinkHandlerMock_UpdateLink_Call struct {
+	*mock.Call
+}
+
+// UpdateLink is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *LinkHandlerMock_Expecter) UpdateLink(w interface{}, r interface{}) *LinkHandlerMock_UpdateLink_Call {
+	return &LinkHandlerMock_UpdateLink_Call{Call: _e.mock.On("UpdateLink", w, r)}
+}
+
+func (_c *LinkHandlerMock_UpdateLink_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *LinkHandlerMock_UpdateLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *LinkHandlerMock_UpdateLink_Call) Return() *LinkHandlerMock_UpdateLink_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LinkHandlerMock_UpdateLink_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *LinkHandlerMock_UpdateLink_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewLinkHandlerMock creates a new instance of LinkHandlerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLinkHandlerMock(t interface {
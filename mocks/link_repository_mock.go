@@ -22,6 +22,64 @@ func (_m *LinkRepositoryMock) EXPECT() *LinkRepositoryMock_Expecter {
 	return &LinkRepositoryMock_Expecter{mock: &_m.Mock}
 }
 
+// CountLinksByUserID provides a mock function with given fields: ctx, userID, query
+func (_m *LinkRepositoryMock) CountLinksByUserID(ctx context.Context, userID string, query models.LinkQuery) (int, error) {
+	ret := _m.Called(ctx, userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLinksByUserID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.LinkQuery) (int, error)); ok {
+		return rf(ctx, userID, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.LinkQuery) int); ok {
+		r0 = rf(ctx, userID, query)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.LinkQuery) error); ok {
+		r1 = rf(ctx, userID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepositoryMock_CountLinksByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountLinksByUserID'
+type LinkRepositoryMock_CountLinksByUserID_Call struct {
+	*mock.Call
+}
+
+// CountLinksByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - query models.LinkQuery
+func (_e *LinkRepositoryMock_Expecter) CountLinksByUserID(ctx interface{}, userID interface{}, query interface{}) *LinkRepositoryMock_CountLinksByUserID_Call {
+	return &LinkRepositoryMock_CountLinksByUserID_Call{Call: _e.mock.On("CountLinksByUserID", ctx, userID, query)}
+}
+
+func (_c *LinkRepositoryMock_CountLinksByUserID_Call) Run(run func(ctx context.Context, userID string, query models.LinkQuery)) *LinkRepositoryMock_CountLinksByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.LinkQuery))
+	})
+	return _c
+}
+
+func (_c *LinkRepositoryMock_CountLinksByUserID_Call) Return(_a0 int, _a1 error) *LinkRepositoryMock_CountLinksByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepositoryMock_CountLinksByUserID_Call) RunAndReturn(run func(context.Context, string, models.LinkQuery) (int, error)) *LinkRepositoryMock_CountLinksByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateLink provides a mock function with given fields: ctx, link
 func (_m *LinkRepositoryMock) CreateLink(ctx context.Context, link models.Link) error {
 	ret := _m.Called(ctx, link)
@@ -69,24 +127,284 @@ func (_c *LinkRepositoryMock_CreateLink_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// GetAllShortCodesByUserID provides a mock function with given fields: ctx, userID
-func (_m *LinkRepositoryMock) GetAllShortCodesByUserID(ctx context.Context, userID string) ([]string, error) {
+// CreateLinks provides a mock function with given fields: ctx, links
+func (_m *LinkRepositoryMock) CreateLinks(ctx context.Context, links []models.Link) error {
+	ret := _m.Called(ctx, links)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLinks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.Link) error); ok {
+		r0 = rf(ctx, links)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepositoryMock_CreateLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLinks'
+type LinkRepositoryMock_CreateLinks_Call struct {
+	*mock.Call
+}
+
+// CreateLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - links []models.Link
+func (_e *LinkRepositoryMock_Expecter) CreateLinks(ctx interface{}, links interface{}) *LinkRepositoryMock_CreateLinks_Call {
+	return &LinkRepositoryMock_CreateLinks_Call{Call: _e.mock.On("CreateLinks", ctx, links)}
+}
+
+func (_c *LinkRepositoryMock_CreateLinks_Call) Run(run func(ctx context.Context, links []models.Link)) *LinkRepositoryMock_CreateLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.Link))
+	})
+	return _c
+}
+
+func (_c *LinkRepositoryMock_CreateLinks_Call) Return(_a0 error) *LinkRepositoryMock_CreateLinks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepositoryMock_CreateLinks_Call) RunAndReturn(run func(context.Context, []models.Link) error) *LinkRepositoryMock_CreateLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLink provides a mock function with given fields: ctx, ID
+func (_m *LinkRepositoryMock) DeleteLink(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepositoryMock_DeleteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLink'
+type LinkRepositoryMock_DeleteLink_Call struct {
+	*mock.Call
+}
+
+// DeleteLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *LinkRepositoryMock_Expecter) DeleteLink(ctx interface{}, ID interface{}) *LinkRepositoryMock_DeleteLink_Call {
+	return &LinkRepositoryMock_DeleteLink_Call{Call: _e.mock.On("DeleteLink", ctx, ID)}
+}
+
+func (_c *LinkRepositoryMock_DeleteLink_Call) Run(run func(ctx context.Context, ID string)) *LinkRepositoryMock_DeleteLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LinkRepositoryMock_DeleteLink_Call) Return(_a0 error) *LinkRepositoryMock_DeleteLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepositoryMock_DeleteLink_Call) RunAndReturn(run func(context.Context, string) error) *LinkRepositoryMock_DeleteLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLinksByUserID provides a mock function with given fields: ctx, userID
+func (_m *LinkRepositoryMock) DeleteLinksByUserID(ctx context.Context, userID string) error {
 	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllShortCodesByUserID")
+		panic("no return value specified for DeleteLinksByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepositoryMock_DeleteLinksByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLinksByUserID'
+type LinkRepositoryMock_DeleteLinksByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteLinksByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *LinkRepositoryMock_Expecter) DeleteLinksByUserID(ctx interface{}, userID interface{}) *LinkRepositoryMock_DeleteLinksByUserID_Call {
+	return &LinkRepositoryMock_DeleteLinksByUserID_Call{Call: _e.mock.On("DeleteLinksByUserID", ctx, userID)}
+}
+
+func (_c *LinkRepositoryMock_DeleteLinksByUserID_Call) Run(run func(ctx context.Context, userID string)) *LinkRepositoryMock_DeleteLinksByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LinkRepositoryMock_DeleteLinksByUserID_Call) Return(_a0 error) *LinkRepositoryMock_DeleteLinksByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepositoryMock_DeleteLinksByUserID_Call) RunAndReturn(run func(context.Context, string) error) *LinkRepositoryMock_DeleteLinksByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDestinationsByLinkIDs provides a mock function with given fields: ctx, linkIDs
+func (_m *LinkRepositoryMock) GetDestinationsByLinkIDs(ctx context.Context, linkIDs []string) (map[string][]models.LinkDestination, error) {
+	ret := _m.Called(ctx, linkIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDestinationsByLinkIDs")
+	}
+
+	var r0 map[string][]models.LinkDestination
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]models.LinkDestination, error)); ok {
+		return rf(ctx, linkIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]models.LinkDestination); ok {
+		r0 = rf(ctx, linkIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]models.LinkDestination)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, linkIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepositoryMock_GetDestinationsByLinkIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDestinationsByLinkIDs'
+type LinkRepositoryMock_GetDestinationsByLinkIDs_Call struct {
+	*mock.Call
+}
+
+// GetDestinationsByLinkIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkIDs []string
+func (_e *LinkRepositoryMock_Expecter) GetDestinationsByLinkIDs(ctx interface{}, linkIDs interface{}) *LinkRepositoryMock_GetDestinationsByLinkIDs_Call {
+	return &LinkRepositoryMock_GetDestinationsByLinkIDs_Call{Call: _e.mock.On("GetDestinationsByLinkIDs", ctx, linkIDs)}
+}
+
+func (_c *LinkRepositoryMock_GetDestinationsByLinkIDs_Call) Run(run func(ctx context.Context, linkIDs []string)) *LinkRepositoryMock_GetDestinationsByLinkIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *LinkRepositoryMock_GetDestinationsByLinkIDs_Call) Return(_a0 map[string][]models.LinkDestination, _a1 error) *LinkRepositoryMock_GetDestinationsByLinkIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepositoryMock_GetDestinationsByLinkIDs_Call) RunAndReturn(run func(context.Context, []string) (map[string][]models.LinkDestination, error)) *LinkRepositoryMock_GetDestinationsByLinkIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExistingShortCodes provides a mock function with given fields: ctx, domain, shortCodes
+func (_m *LinkRepositoryMock) GetExistingShortCodes(ctx context.Context, domain string, shortCodes []string) ([]string, error) {
+	ret := _m.Called(ctx, domain, shortCodes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExistingShortCodes")
 	}
 
 	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]string, error)); ok {
+		return rf(ctx, domain, shortCodes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []string); ok {
+		r0 = rf(ctx, domain, shortCodes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, domain, shortCodes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkRepositoryMock_GetExistingShortCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExistingShortCodes'
+type LinkRepositoryMock_GetExistingShortCodes_Call struct {
+	*mock.Call
+}
+
+// GetExistingShortCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domain string
+//   - shortCodes []string
+func (_e *LinkRepositoryMock_Expecter) GetExistingShortCodes(ctx interface{}, domain interface{}, shortCodes interface{}) *LinkRepositoryMock_GetExistingShortCodes_Call {
+	return &LinkRepositoryMock_GetExistingShortCodes_Call{Call: _e.mock.On("GetExistingShortCodes", ctx, domain, shortCodes)}
+}
+
+func (_c *LinkRepositoryMock_GetExistingShortCodes_Call) Run(run func(ctx context.Context, domain string, shortCodes []string)) *LinkRepositoryMock_GetExistingShortCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *LinkRepositoryMock_GetExistingShortCodes_Call) Return(_a0 []string, _a1 error) *LinkRepositoryMock_GetExistingShortCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkRepositoryMock_GetExistingShortCodes_Call) RunAndReturn(run func(context.Context, string, []string) ([]string, error)) *LinkRepositoryMock_GetExistingShortCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLinkAddressesByUserID provides a mock function with given fields: ctx, userID
+func (_m *LinkRepositoryMock) GetLinkAddressesByUserID(ctx context.Context, userID string) ([]models.LinkAddress, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinkAddressesByUserID")
+	}
+
+	var r0 []models.LinkAddress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.LinkAddress, error)); ok {
 		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.LinkAddress); ok {
 		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).([]models.LinkAddress)
 		}
 	}
 
@@ -99,31 +417,31 @@ func (_m *LinkRepositoryMock) GetAllShortCodesByUserID(ctx context.Context, user
 	return r0, r1
 }
 
-// LinkRepositoryMock_GetAllShortCodesByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllShortCodesByUserID'
-type LinkRepositoryMock_GetAllShortCodesByUserID_Call struct {
+// LinkRepositoryMock_GetLinkAddressesByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinkAddressesByUserID'
+type LinkRepositoryMock_GetLinkAddressesByUserID_Call struct {
 	*mock.Call
 }
 
-// GetAllShortCodesByUserID is a helper method to define mock.On call
+// GetLinkAddressesByUserID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *LinkRepositoryMock_Expecter) GetAllShortCodesByUserID(ctx interface{}, userID interface{}) *LinkRepositoryMock_GetAllShortCodesByUserID_Call {
-	return &LinkRepositoryMock_GetAllShortCodesByUserID_Call{Call: _e.mock.On("GetAllShortCodesByUserID", ctx, userID)}
+func (_e *LinkRepositoryMock_Expecter) GetLinkAddressesByUserID(ctx interface{}, userID interface{}) *LinkRepositoryMock_GetLinkAddressesByUserID_Call {
+	return &LinkRepositoryMock_GetLinkAddressesByUserID_Call{Call: _e.mock.On("GetLinkAddressesByUserID", ctx, userID)}
 }
 
-func (_c *LinkRepositoryMock_GetAllShortCodesByUserID_Call) Run(run func(ctx context.Context, userID string)) *LinkRepositoryMock_GetAllShortCodesByUserID_Call {
+func (_c *LinkRepositoryMock_GetLinkAddressesByUserID_Call) Run(run func(ctx context.Context, userID string)) *LinkRepositoryMock_GetLinkAddressesByUserID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *LinkRepositoryMock_GetAllShortCodesByUserID_Call) Return(_a0 []string, _a1 error) *LinkRepositoryMock_GetAllShortCodesByUserID_Call {
+func (_c *LinkRepositoryMock_GetLinkAddressesByUserID_Call) Return(_a0 []models.LinkAddress, _a1 error) *LinkRepositoryMock_GetLinkAddressesByUserID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *LinkRepositoryMock_GetAllShortCodesByUserID_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *LinkRepositoryMock_GetAllShortCodesByUserID_Call {
+func (_c *LinkRepositoryMock_GetLinkAddressesByUserID_Call) RunAndReturn(run func(context.Context, string) ([]models.LinkAddress, error)) *LinkRepositoryMock_GetLinkAddressesByUserID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -187,9 +505,9 @@ func (_c *LinkRepositoryMock_GetLinkByID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// GetLinkByShortCode provides a mock function with given fields: ctx, shortCode
-func (_m *LinkRepositoryMock) GetLinkByShortCode(ctx context.Context, shortCode string) (*models.Link, error) {
-	ret := _m.Called(ctx, shortCode)
+// GetLinkByShortCode provides a mock function with given fields: ctx, domain, shortCode
+func (_m *LinkRepositoryMock) GetLinkByShortCode(ctx context.Context, domain string, shortCode string) (*models.Link, error) {
+	ret := _m.Called(ctx, domain, shortCode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLinkByShortCode")
@@ -197,19 +515,19 @@ func (_m *LinkRepositoryMock) GetLinkByShortCode(ctx context.Context, shortCode
 
 	var r0 *models.Link
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Link, error)); ok {
-		return rf(ctx, shortCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Link, error)); ok {
+		return rf(ctx, domain, shortCode)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Link); ok {
-		r0 = rf(ctx, shortCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Link); ok {
+		r0 = rf(ctx, domain, shortCode)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Link)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, shortCode)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, domain, shortCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -224,14 +542,15 @@ type LinkRepositoryMock_GetLinkByShortCode_Call struct {
 
 // GetLinkByShortCode is a helper method to define mock.On call
 //   - ctx context.Context
+//   - domain string
 //   - shortCode string
-func (_e *LinkRepositoryMock_Expecter) GetLinkByShortCode(ctx interface{}, shortCode interface{}) *LinkRepositoryMock_GetLinkByShortCode_Call {
-	return &LinkRepositoryMock_GetLinkByShortCode_Call{Call: _e.mock.On("GetLinkByShortCode", ctx, shortCode)}
+func (_e *LinkRepositoryMock_Expecter) GetLinkByShortCode(ctx interface{}, domain interface{}, shortCode interface{}) *LinkRepositoryMock_GetLinkByShortCode_Call {
+	return &LinkRepositoryMock_GetLinkByShortCode_Call{Call: _e.mock.On("GetLinkByShortCode", ctx, domain, shortCode)}
 }
 
-func (_c *LinkRepositoryMock_GetLinkByShortCode_Call) Run(run func(ctx context.Context, shortCode string)) *LinkRepositoryMock_GetLinkByShortCode_Call {
+func (_c *LinkRepositoryMock_GetLinkByShortCode_Call) Run(run func(ctx context.Context, domain string, shortCode string)) *LinkRepositoryMock_GetLinkByShortCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -241,14 +560,14 @@ func (_c *LinkRepositoryMock_GetLinkByShortCode_Call) Return(_a0 *models.Link, _
 	return _c
 }
 
-func (_c *LinkRepositoryMock_GetLinkByShortCode_Call) RunAndReturn(run func(context.Context, string) (*models.Link, error)) *LinkRepositoryMock_GetLinkByShortCode_Call {
+func (_c *LinkRepositoryMock_GetLinkByShortCode_Call) RunAndReturn(run func(context.Context, string, string) (*models.Link, error)) *LinkRepositoryMock_GetLinkByShortCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLinksByUserID provides a mock function with given fields: ctx, userID
-func (_m *LinkRepositoryMock) GetLinksByUserID(ctx context.Context, userID string) ([]models.Link, error) {
-	ret := _m.Called(ctx, userID)
+// GetLinksByUserID provides a mock function with given fields: ctx, userID, query
+func (_m *LinkRepositoryMock) GetLinksByUserID(ctx context.Context, userID string, query models.LinkQuery) ([]models.Link, error) {
+	ret := _m.Called(ctx, userID, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLinksByUserID")
@@ -256,19 +575,19 @@ func (_m *LinkRepositoryMock) GetLinksByUserID(ctx context.Context, userID strin
 
 	var r0 []models.Link
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Link, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.LinkQuery) ([]models.Link, error)); ok {
+		return rf(ctx, userID, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Link); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.LinkQuery) []models.Link); ok {
+		r0 = rf(ctx, userID, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Link)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.LinkQuery) error); ok {
+		r1 = rf(ctx, userID, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -284,13 +603,14 @@ type LinkRepositoryMock_GetLinksByUserID_Call struct {
 // GetLinksByUserID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *LinkRepositoryMock_Expecter) GetLinksByUserID(ctx interface{}, userID interface{}) *LinkRepositoryMock_GetLinksByUserID_Call {
-	return &LinkRepositoryMock_GetLinksByUserID_Call{Call: _e.mock.On("GetLinksByUserID", ctx, userID)}
+//   - query models.LinkQuery
+func (_e *LinkRepositoryMock_Expecter) GetLinksByUserID(ctx interface{}, userID interface{}, query interface{}) *LinkRepositoryMock_GetLinksByUserID_Call {
+	return &LinkRepositoryMock_GetLinksByUserID_Call{Call: _e.mock.On("GetLinksByUserID", ctx, userID, query)}
 }
 
-func (_c *LinkRepositoryMock_GetLinksByUserID_Call) Run(run func(ctx context.Context, userID string)) *LinkRepositoryMock_GetLinksByUserID_Call {
+func (_c *LinkRepositoryMock_GetLinksByUserID_Call) Run(run func(ctx context.Context, userID string, query models.LinkQuery)) *LinkRepositoryMock_GetLinksByUserID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(models.LinkQuery))
 	})
 	return _c
 }
@@ -300,14 +620,14 @@ func (_c *LinkRepositoryMock_GetLinksByUserID_Call) Return(_a0 []models.Link, _a
 	return _c
 }
 
-func (_c *LinkRepositoryMock_GetLinksByUserID_Call) RunAndReturn(run func(context.Context, string) ([]models.Link, error)) *LinkRepositoryMock_GetLinksByUserID_Call {
+func (_c *LinkRepositoryMock_GetLinksByUserID_Call) RunAndReturn(run func(context.Context, string, models.LinkQuery) ([]models.Link, error)) *LinkRepositoryMock_GetLinksByUserID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOriginalURLByShortCode provides a mock function with given fields: ctx, shortCode
-func (_m *LinkRepositoryMock) GetOriginalURLByShortCode(ctx context.Context, shortCode string) (string, error) {
-	ret := _m.Called(ctx, shortCode)
+// GetOriginalURLByShortCode provides a mock function with given fields: ctx, domain, shortCode
+func (_m *LinkRepositoryMock) GetOriginalURLByShortCode(ctx context.Context, domain string, shortCode string) (string, error) {
+	ret := _m.Called(ctx, domain, shortCode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetOriginalURLByShortCode")
@@ -315,17 +635,17 @@ func (_m *LinkRepositoryMock) GetOriginalURLByShortCode(ctx context.Context, sho
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, shortCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, domain, shortCode)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, shortCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, domain, shortCode)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, shortCode)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, domain, shortCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -340,14 +660,15 @@ type LinkRepositoryMock_GetOriginalURLByShortCode_Call struct {
 
 // GetOriginalURLByShortCode is a helper method to define mock.On call
 //   - ctx context.Context
+//   - domain string
 //   - shortCode string
-func (_e *LinkRepositoryMock_Expecter) GetOriginalURLByShortCode(ctx interface{}, shortCode interface{}) *LinkRepositoryMock_GetOriginalURLByShortCode_Call {
-	return &LinkRepositoryMock_GetOriginalURLByShortCode_Call{Call: _e.mock.On("GetOriginalURLByShortCode", ctx, shortCode)}
+func (_e *LinkRepositoryMock_Expecter) GetOriginalURLByShortCode(ctx interface{}, domain interface{}, shortCode interface{}) *LinkRepositoryMock_GetOriginalURLByShortCode_Call {
+	return &LinkRepositoryMock_GetOriginalURLByShortCode_Call{Call: _e.mock.On("GetOriginalURLByShortCode", ctx, domain, shortCode)}
 }
 
-func (_c *LinkRepositoryMock_GetOriginalURLByShortCode_Call) Run(run func(ctx context.Context, shortCode string)) *LinkRepositoryMock_GetOriginalURLByShortCode_Call {
+func (_c *LinkRepositoryMock_GetOriginalURLByShortCode_Call) Run(run func(ctx context.Context, domain string, shortCode string)) *LinkRepositoryMock_GetOriginalURLByShortCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -357,7 +678,150 @@ func (_c *LinkRepositoryMock_GetOriginalURLByShortCode_Call) Return(_a0 string,
 	return _c
 }
 
-func (_c *LinkRepositoryMock_GetOriginalURLByShortCode_Call) RunAndReturn(run func(context.Context, string) (string, error)) *LinkRepositoryMock_GetOriginalURLByShortCode_Call {
+func (_c *LinkRepositoryMock_GetOriginalURLByShortCode_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *LinkRepositoryMock_GetOriginalURLByShortCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterVisit provides a mock function with given fields: ctx, linkVisit
+func (_m *LinkRepositoryMock) RegisterVisit(ctx context.Context, linkVisit *models.LinkVisit) error {
+	ret := _m.Called(ctx, linkVisit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterVisit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.LinkVisit) error); ok {
+		r0 = rf(ctx, linkVisit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepositoryMock_RegisterVisit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterVisit'
+type LinkRepositoryMock_RegisterVisit_Call struct {
+	*mock.Call
+}
+
+// RegisterVisit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkVisit *models.LinkVisit
+func (_e *LinkRepositoryMock_Expecter) RegisterVisit(ctx interface{}, linkVisit interface{}) *LinkRepositoryMock_RegisterVisit_Call {
+	return &LinkRepositoryMock_RegisterVisit_Call{Call: _e.mock.On("RegisterVisit", ctx, linkVisit)}
+}
+
+func (_c *LinkRepositoryMock_RegisterVisit_Call) Run(run func(ctx context.Context, linkVisit *models.LinkVisit)) *LinkRepositoryMock_RegisterVisit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.LinkVisit))
+	})
+	return _c
+}
+
+func (_c *LinkRepositoryMock_RegisterVisit_Call) Return(_a0 error) *LinkRepositoryMock_RegisterVisit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepositoryMock_RegisterVisit_Call) RunAndReturn(run func(context.Context, *models.LinkVisit) error) *LinkRepositoryMock_RegisterVisit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamLinksByUserID provides a mock function with given fields: ctx, userID, query, fn
+func (_m *LinkRepositoryMock) StreamLinksByUserID(ctx context.Context, userID string, query models.LinkQuery, fn func(*models.Link) error) error {
+	ret := _m.Called(ctx, userID, query, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamLinksByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.LinkQuery, func(*models.Link) error) error); ok {
+		r0 = rf(ctx, userID, query, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepositoryMock_StreamLinksByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamLinksByUserID'
+type LinkRepositoryMock_StreamLinksByUserID_Call struct {
+	*mock.Call
+}
+
+// StreamLinksByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - query models.LinkQuery
+//   - fn func(*models.Link) error
+func (_e *LinkRepositoryMock_Expecter) StreamLinksByUserID(ctx interface{}, userID interface{}, query interface{}, fn interface{}) *LinkRepositoryMock_StreamLinksByUserID_Call {
+	return &LinkRepositoryMock_StreamLinksByUserID_Call{Call: _e.mock.On("StreamLinksByUserID", ctx, userID, query, fn)}
+}
+
+func (_c *LinkRepositoryMock_StreamLinksByUserID_Call) Run(run func(ctx context.Context, userID string, query models.LinkQuery, fn func(*models.Link) error)) *LinkRepositoryMock_StreamLinksByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.LinkQuery), args[3].(func(*models.Link) error))
+	})
+	return _c
+}
+
+func (_c *LinkRepositoryMock_StreamLinksByUserID_Call) Return(_a0 error) *LinkRepositoryMock_StreamLinksByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepositoryMock_StreamLinksByUserID_Call) RunAndReturn(run func(context.Context, string, models.LinkQuery, func(*models.Link) error) error) *LinkRepositoryMock_StreamLinksByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLink provides a mock function with given fields: ctx, link
+func (_m *LinkRepositoryMock) UpdateLink(ctx context.Context, link models.Link) error {
+	ret := _m.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Link) error); ok {
+		r0 = rf(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkRepositoryMock_UpdateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLink'
+type LinkRepositoryMock_UpdateLink_Call struct {
+	*mock.Call
+}
+
+// UpdateLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link models.Link
+func (_e *LinkRepositoryMock_Expecter) UpdateLink(ctx interface{}, link interface{}) *LinkRepositoryMock_UpdateLink_Call {
+	return &LinkRepositoryMock_UpdateLink_Call{Call: _e.mock.On("UpdateLink", ctx, link)}
+}
+
+func (_c *LinkRepositoryMock_UpdateLink_Call) Run(run func(ctx context.Context, link models.Link)) *LinkRepositoryMock_UpdateLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Link))
+	})
+	return _c
+}
+
+func (_c *LinkRepositoryMock_UpdateLink_Call) Return(_a0 error) *LinkRepositoryMock_UpdateLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkRepositoryMock_UpdateLink_Call) RunAndReturn(run func(context.Context, models.Link) error) *LinkRepositoryMock_UpdateLink_Call {
 	_c.Call.Return(run)
 	return _c
 }
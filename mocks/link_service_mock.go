@@ -4,9 +4,11 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 
-	models "github.com/g-villarinho/link-fizz-api/models"
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/g-villarinho/link-fizz-api/models"
 )
 
 // LinkServiceMock is an autogenerated mock type for the LinkService type
@@ -22,9 +24,9 @@ func (_m *LinkServiceMock) EXPECT() *LinkServiceMock_Expecter {
 	return &LinkServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// CreateLink provides a mock function with given fields: ctx, userID, destinationURL, title, customCode
-func (_m *LinkServiceMock) CreateLink(ctx context.Context, userID string, destinationURL string, title *string, customCode *string) (*models.CreateLinkResponse, error) {
-	ret := _m.Called(ctx, userID, destinationURL, title, customCode)
+// CreateLink provides a mock function with given fields: ctx, userID, payload
+func (_m *LinkServiceMock) CreateLink(ctx context.Context, userID string, payload models.LinkPayload) (*models.CreateLinkResponse, error) {
+	ret := _m.Called(ctx, userID, payload)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateLink")
@@ -32,19 +34,19 @@ func (_m *LinkServiceMock) CreateLink(ctx context.Context, userID string, destin
 
 	var r0 *models.CreateLinkResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string, *string) (*models.CreateLinkResponse, error)); ok {
-		return rf(ctx, userID, destinationURL, title, customCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.LinkPayload) (*models.CreateLinkResponse, error)); ok {
+		return rf(ctx, userID, payload)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string, *string) *models.CreateLinkResponse); ok {
-		r0 = rf(ctx, userID, destinationURL, title, customCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.LinkPayload) *models.CreateLinkResponse); ok {
+		r0 = rf(ctx, userID, payload)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.CreateLinkResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, *string, *string) error); ok {
-		r1 = rf(ctx, userID, destinationURL, title, customCode)
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.LinkPayload) error); ok {
+		r1 = rf(ctx, userID, payload)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,16 +62,14 @@ type LinkServiceMock_CreateLink_Call struct {
 // CreateLink is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-//   - destinationURL string
-//   - title *string
-//   - customCode *string
-func (_e *LinkServiceMock_Expecter) CreateLink(ctx interface{}, userID interface{}, destinationURL interface{}, title interface{}, customCode interface{}) *LinkServiceMock_CreateLink_Call {
-	return &LinkServiceMock_CreateLink_Call{Call: _e.mock.On("CreateLink", ctx, userID, destinationURL, title, customCode)}
+//   - payload models.LinkPayload
+func (_e *LinkServiceMock_Expecter) CreateLink(ctx interface{}, userID interface{}, payload interface{}) *LinkServiceMock_CreateLink_Call {
+	return &LinkServiceMock_CreateLink_Call{Call: _e.mock.On("CreateLink", ctx, userID, payload)}
 }
 
-func (_c *LinkServiceMock_CreateLink_Call) Run(run func(ctx context.Context, userID string, destinationURL string, title *string, customCode *string)) *LinkServiceMock_CreateLink_Call {
+func (_c *LinkServiceMock_CreateLink_Call) Run(run func(ctx context.Context, userID string, payload models.LinkPayload)) *LinkServiceMock_CreateLink_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*string), args[4].(*string))
+		run(args[0].(context.Context), args[1].(string), args[2].(models.LinkPayload))
 	})
 	return _c
 }
@@ -79,14 +79,123 @@ func (_c *LinkServiceMock_CreateLink_Call) Return(_a0 *models.CreateLinkResponse
 	return _c
 }
 
-func (_c *LinkServiceMock_CreateLink_Call) RunAndReturn(run func(context.Context, string, string, *string, *string) (*models.CreateLinkResponse, error)) *LinkServiceMock_CreateLink_Call {
+func (_c *LinkServiceMock_CreateLink_Call) RunAndReturn(run func(context.Context, string, models.LinkPayload) (*models.CreateLinkResponse, error)) *LinkServiceMock_CreateLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLinks provides a mock function with given fields: ctx, userID, payloads
+func (_m *LinkServiceMock) CreateLinks(ctx context.Context, userID string, payloads []models.LinkPayload) (*models.BatchLinkResponse, error) {
+	ret := _m.Called(ctx, userID, payloads)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLinks")
+	}
+
+	var r0 *models.BatchLinkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []models.LinkPayload) (*models.BatchLinkResponse, error)); ok {
+		return rf(ctx, userID, payloads)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []models.LinkPayload) *models.BatchLinkResponse); ok {
+		r0 = rf(ctx, userID, payloads)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BatchLinkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []models.LinkPayload) error); ok {
+		r1 = rf(ctx, userID, payloads)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkServiceMock_CreateLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLinks'
+type LinkServiceMock_CreateLinks_Call struct {
+	*mock.Call
+}
+
+// CreateLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - payloads []models.LinkPayload
+func (_e *LinkServiceMock_Expecter) CreateLinks(ctx interface{}, userID interface{}, payloads interface{}) *LinkServiceMock_CreateLinks_Call {
+	return &LinkServiceMock_CreateLinks_Call{Call: _e.mock.On("CreateLinks", ctx, userID, payloads)}
+}
+
+func (_c *LinkServiceMock_CreateLinks_Call) Run(run func(ctx context.Context, userID string, payloads []models.LinkPayload)) *LinkServiceMock_CreateLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]models.LinkPayload))
+	})
+	return _c
+}
+
+func (_c *LinkServiceMock_CreateLinks_Call) Return(_a0 *models.BatchLinkResponse, _a1 error) *LinkServiceMock_CreateLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkServiceMock_CreateLinks_Call) RunAndReturn(run func(context.Context, string, []models.LinkPayload) (*models.BatchLinkResponse, error)) *LinkServiceMock_CreateLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLink provides a mock function with given fields: ctx, userID, domain, shortCode
+func (_m *LinkServiceMock) DeleteLink(ctx context.Context, userID string, domain string, shortCode string) error {
+	ret := _m.Called(ctx, userID, domain, shortCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, userID, domain, shortCode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkServiceMock_DeleteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLink'
+type LinkServiceMock_DeleteLink_Call struct {
+	*mock.Call
+}
+
+// DeleteLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - domain string
+//   - shortCode string
+func (_e *LinkServiceMock_Expecter) DeleteLink(ctx interface{}, userID interface{}, domain interface{}, shortCode interface{}) *LinkServiceMock_DeleteLink_Call {
+	return &LinkServiceMock_DeleteLink_Call{Call: _e.mock.On("DeleteLink", ctx, userID, domain, shortCode)}
+}
+
+func (_c *LinkServiceMock_DeleteLink_Call) Run(run func(ctx context.Context, userID string, domain string, shortCode string)) *LinkServiceMock_DeleteLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *LinkServiceMock_DeleteLink_Call) Return(_a0 error) *LinkServiceMock_DeleteLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkServiceMock_DeleteLink_Call) RunAndReturn(run func(context.Context, string, string, string) error) *LinkServiceMock_DeleteLink_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLinkByShortCode provides a mock function with given fields: ctx, shortCode
-func (_m *LinkServiceMock) GetLinkByShortCode(ctx context.Context, shortCode string) (*models.Link, error) {
-	ret := _m.Called(ctx, shortCode)
+// GetLinkByShortCode provides a mock function with given fields: ctx, domain, shortCode
+func (_m *LinkServiceMock) GetLinkByShortCode(ctx context.Context, domain string, shortCode string) (*models.Link, error) {
+	ret := _m.Called(ctx, domain, shortCode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLinkByShortCode")
@@ -94,19 +203,19 @@ func (_m *LinkServiceMock) GetLinkByShortCode(ctx context.Context, shortCode str
 
 	var r0 *models.Link
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Link, error)); ok {
-		return rf(ctx, shortCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Link, error)); ok {
+		return rf(ctx, domain, shortCode)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Link); ok {
-		r0 = rf(ctx, shortCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Link); ok {
+		r0 = rf(ctx, domain, shortCode)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Link)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, shortCode)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, domain, shortCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -121,14 +230,15 @@ type LinkServiceMock_GetLinkByShortCode_Call struct {
 
 // GetLinkByShortCode is a helper method to define mock.On call
 //   - ctx context.Context
+//   - domain string
 //   - shortCode string
-func (_e *LinkServiceMock_Expecter) GetLinkByShortCode(ctx interface{}, shortCode interface{}) *LinkServiceMock_GetLinkByShortCode_Call {
-	return &LinkServiceMock_GetLinkByShortCode_Call{Call: _e.mock.On("GetLinkByShortCode", ctx, shortCode)}
+func (_e *LinkServiceMock_Expecter) GetLinkByShortCode(ctx interface{}, domain interface{}, shortCode interface{}) *LinkServiceMock_GetLinkByShortCode_Call {
+	return &LinkServiceMock_GetLinkByShortCode_Call{Call: _e.mock.On("GetLinkByShortCode", ctx, domain, shortCode)}
 }
 
-func (_c *LinkServiceMock_GetLinkByShortCode_Call) Run(run func(ctx context.Context, shortCode string)) *LinkServiceMock_GetLinkByShortCode_Call {
+func (_c *LinkServiceMock_GetLinkByShortCode_Call) Run(run func(ctx context.Context, domain string, shortCode string)) *LinkServiceMock_GetLinkByShortCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -138,14 +248,135 @@ func (_c *LinkServiceMock_GetLinkByShortCode_Call) Return(_a0 *models.Link, _a1
 	return _c
 }
 
-func (_c *LinkServiceMock_GetLinkByShortCode_Call) RunAndReturn(run func(context.Context, string) (*models.Link, error)) *LinkServiceMock_GetLinkByShortCode_Call {
+func (_c *LinkServiceMock_GetLinkByShortCode_Call) RunAndReturn(run func(context.Context, string, string) (*models.Link, error)) *LinkServiceMock_GetLinkByShortCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLinkDetails provides a mock function with given fields: ctx, userID, domain, shortCode
+func (_m *LinkServiceMock) GetLinkDetails(ctx context.Context, userID string, domain string, shortCode string) (*models.LinkResponse, error) {
+	ret := _m.Called(ctx, userID, domain, shortCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinkDetails")
+	}
+
+	var r0 *models.LinkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.LinkResponse, error)); ok {
+		return rf(ctx, userID, domain, shortCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.LinkResponse); ok {
+		r0 = rf(ctx, userID, domain, shortCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LinkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, userID, domain, shortCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkServiceMock_GetLinkDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinkDetails'
+type LinkServiceMock_GetLinkDetails_Call struct {
+	*mock.Call
+}
+
+// GetLinkDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - domain string
+//   - shortCode string
+func (_e *LinkServiceMock_Expecter) GetLinkDetails(ctx interface{}, userID interface{}, domain interface{}, shortCode interface{}) *LinkServiceMock_GetLinkDetails_Call {
+	return &LinkServiceMock_GetLinkDetails_Call{Call: _e.mock.On("GetLinkDetails", ctx, userID, domain, shortCode)}
+}
+
+func (_c *LinkServiceMock_GetLinkDetails_Call) Run(run func(ctx context.Context, userID string, domain string, shortCode string)) *LinkServiceMock_GetLinkDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *LinkServiceMock_GetLinkDetails_Call) Return(_a0 *models.LinkResponse, _a1 error) *LinkServiceMock_GetLinkDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkServiceMock_GetLinkDetails_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.LinkResponse, error)) *LinkServiceMock_GetLinkDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLinksByUserID provides a mock function with given fields: ctx, userID, query
+func (_m *LinkServiceMock) GetLinksByUserID(ctx context.Context, userID string, query models.LinkQuery) (*models.LinkPage, error) {
+	ret := _m.Called(ctx, userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinksByUserID")
+	}
+
+	var r0 *models.LinkPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.LinkQuery) (*models.LinkPage, error)); ok {
+		return rf(ctx, userID, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.LinkQuery) *models.LinkPage); ok {
+		r0 = rf(ctx, userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LinkPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.LinkQuery) error); ok {
+		r1 = rf(ctx, userID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkServiceMock_GetLinksByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinksByUserID'
+type LinkServiceMock_GetLinksByUserID_Call struct {
+	*mock.Call
+}
+
+// GetLinksByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - query models.LinkQuery
+func (_e *LinkServiceMock_Expecter) GetLinksByUserID(ctx interface{}, userID interface{}, query interface{}) *LinkServiceMock_GetLinksByUserID_Call {
+	return &LinkServiceMock_GetLinksByUserID_Call{Call: _e.mock.On("GetLinksByUserID", ctx, userID, query)}
+}
+
+func (_c *LinkServiceMock_GetLinksByUserID_Call) Run(run func(ctx context.Context, userID string, query models.LinkQuery)) *LinkServiceMock_GetLinksByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.LinkQuery))
+	})
+	return _c
+}
+
+func (_c *LinkServiceMock_GetLinksByUserID_Call) Return(_a0 *models.LinkPage, _a1 error) *LinkServiceMock_GetLinksByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkServiceMock_GetLinksByUserID_Call) RunAndReturn(run func(context.Context, string, models.LinkQuery) (*models.LinkPage, error)) *LinkServiceMock_GetLinksByUserID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOriginalURLByShortCode provides a mock function with given fields: ctx, shortCode
-func (_m *LinkServiceMock) GetOriginalURLByShortCode(ctx context.Context, shortCode string) (string, error) {
-	ret := _m.Called(ctx, shortCode)
+// GetOriginalURLByShortCode provides a mock function with given fields: ctx, domain, shortCode
+func (_m *LinkServiceMock) GetOriginalURLByShortCode(ctx context.Context, domain string, shortCode string) (string, error) {
+	ret := _m.Called(ctx, domain, shortCode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetOriginalURLByShortCode")
@@ -153,17 +384,17 @@ func (_m *LinkServiceMock) GetOriginalURLByShortCode(ctx context.Context, shortC
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, shortCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, domain, shortCode)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, shortCode)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, domain, shortCode)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, shortCode)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, domain, shortCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -178,14 +409,15 @@ type LinkServiceMock_GetOriginalURLByShortCode_Call struct {
 
 // GetOriginalURLByShortCode is a helper method to define mock.On call
 //   - ctx context.Context
+//   - domain string
 //   - shortCode string
-func (_e *LinkServiceMock_Expecter) GetOriginalURLByShortCode(ctx interface{}, shortCode interface{}) *LinkServiceMock_GetOriginalURLByShortCode_Call {
-	return &LinkServiceMock_GetOriginalURLByShortCode_Call{Call: _e.mock.On("GetOriginalURLByShortCode", ctx, shortCode)}
+func (_e *LinkServiceMock_Expecter) GetOriginalURLByShortCode(ctx interface{}, domain interface{}, shortCode interface{}) *LinkServiceMock_GetOriginalURLByShortCode_Call {
+	return &LinkServiceMock_GetOriginalURLByShortCode_Call{Call: _e.mock.On("GetOriginalURLByShortCode", ctx, domain, shortCode)}
 }
 
-func (_c *LinkServiceMock_GetOriginalURLByShortCode_Call) Run(run func(ctx context.Context, shortCode string)) *LinkServiceMock_GetOriginalURLByShortCode_Call {
+func (_c *LinkServiceMock_GetOriginalURLByShortCode_Call) Run(run func(ctx context.Context, domain string, shortCode string)) *LinkServiceMock_GetOriginalURLByShortCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -195,7 +427,7 @@ func (_c *LinkServiceMock_GetOriginalURLByShortCode_Call) Return(_a0 string, _a1
 	return _c
 }
 
-func (_c *LinkServiceMock_GetOriginalURLByShortCode_Call) RunAndReturn(run func(context.Context, string) (string, error)) *LinkServiceMock_GetOriginalURLByShortCode_Call {
+func (_c *LinkServiceMock_GetOriginalURLByShortCode_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *LinkServiceMock_GetOriginalURLByShortCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -259,6 +491,128 @@ func (_c *LinkServiceMock_GetUsersShortURLs_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ImportLinks provides a mock function with given fields: ctx, userID, reader
+func (_m *LinkServiceMock) ImportLinks(ctx context.Context, userID string, reader io.Reader) (*models.BatchLinkResponse, error) {
+	ret := _m.Called(ctx, userID, reader)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportLinks")
+	}
+
+	var r0 *models.BatchLinkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) (*models.BatchLinkResponse, error)); ok {
+		return rf(ctx, userID, reader)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) *models.BatchLinkResponse); ok {
+		r0 = rf(ctx, userID, reader)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BatchLinkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader) error); ok {
+		r1 = rf(ctx, userID, reader)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkServiceMock_ImportLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportLinks'
+type LinkServiceMock_ImportLinks_Call struct {
+	*mock.Call
+}
+
+// ImportLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - reader io.Reader
+func (_e *LinkServiceMock_Expecter) ImportLinks(ctx interface{}, userID interface{}, reader interface{}) *LinkServiceMock_ImportLinks_Call {
+	return &LinkServiceMock_ImportLinks_Call{Call: _e.mock.On("ImportLinks", ctx, userID, reader)}
+}
+
+func (_c *LinkServiceMock_ImportLinks_Call) Run(run func(ctx context.Context, userID string, reader io.Reader)) *LinkServiceMock_ImportLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *LinkServiceMock_ImportLinks_Call) Return(_a0 *models.BatchLinkResponse, _a1 error) *LinkServiceMock_ImportLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkServiceMock_ImportLinks_Call) RunAndReturn(run func(context.Context, string, io.Reader) (*models.BatchLinkResponse, error)) *LinkServiceMock_ImportLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLink provides a mock function with given fields: ctx, userID, domain, shortCode, payload
+func (_m *LinkServiceMock) UpdateLink(ctx context.Context, userID string, domain string, shortCode string, payload models.UpdateLinkPayload) (*models.LinkResponse, error) {
+	ret := _m.Called(ctx, userID, domain, shortCode, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLink")
+	}
+
+	var r0 *models.LinkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, models.UpdateLinkPayload) (*models.LinkResponse, error)); ok {
+		return rf(ctx, userID, domain, shortCode, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, models.UpdateLinkPayload) *models.LinkResponse); ok {
+		r0 = rf(ctx, userID, domain, shortCode, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LinkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, models.UpdateLinkPayload) error); ok {
+		r1 = rf(ctx, userID, domain, shortCode, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkServiceMock_UpdateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLink'
+type LinkServiceMock_UpdateLink_Call struct {
+	*mock.Call
+}
+
+// UpdateLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - domain string
+//   - shortCode string
+//   - payload models.UpdateLinkPayload
+func (_e *LinkServiceMock_Expecter) UpdateLink(ctx interface{}, userID interface{}, domain interface{}, shortCode interface{}, payload interface{}) *LinkServiceMock_UpdateLink_Call {
+	return &LinkServiceMock_UpdateLink_Call{Call: _e.mock.On("UpdateLink", ctx, userID, domain, shortCode, payload)}
+}
+
+func (_c *LinkServiceMock_UpdateLink_Call) Run(run func(ctx context.Context, userID string, domain string, shortCode string, payload models.UpdateLinkPayload)) *LinkServiceMock_UpdateLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(models.UpdateLinkPayload))
+	})
+	return _c
+}
+
+func (_c *LinkServiceMock_UpdateLink_Call) Return(_a0 *models.LinkResponse, _a1 error) *LinkServiceMock_UpdateLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkServiceMock_UpdateLink_Call) RunAndReturn(run func(context.Context, string, string, string, models.UpdateLinkPayload) (*models.LinkResponse, error)) *LinkServiceMock_UpdateLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewLinkServiceMock creates a new instance of LinkServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLinkServiceMock(t interface {
@@ -7,6 +7,8 @@ import (
 
 	models "github.com/g-villarinho/link-fizz-api/models"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // LinkVisitRepositoryMock is an autogenerated mock type for the LinkVisitRepository type
@@ -69,6 +71,534 @@ func (_c *LinkVisitRepositoryMock_CreateLinkVisit_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// CreateLinkVisits provides a mock function with given fields: ctx, linkVisits
+func (_m *LinkVisitRepositoryMock) CreateLinkVisits(ctx context.Context, linkVisits []*models.LinkVisit) error {
+	ret := _m.Called(ctx, linkVisits)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLinkVisits")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.LinkVisit) error); ok {
+		r0 = rf(ctx, linkVisits)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkVisitRepositoryMock_CreateLinkVisits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLinkVisits'
+type LinkVisitRepositoryMock_CreateLinkVisits_Call struct {
+	*mock.Call
+}
+
+// CreateLinkVisits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkVisits []*models.LinkVisit
+func (_e *LinkVisitRepositoryMock_Expecter) CreateLinkVisits(ctx interface{}, linkVisits interface{}) *LinkVisitRepositoryMock_CreateLinkVisits_Call {
+	return &LinkVisitRepositoryMock_CreateLinkVisits_Call{Call: _e.mock.On("CreateLinkVisits", ctx, linkVisits)}
+}
+
+func (_c *LinkVisitRepositoryMock_CreateLinkVisits_Call) Run(run func(ctx context.Context, linkVisits []*models.LinkVisit)) *LinkVisitRepositoryMock_CreateLinkVisits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.LinkVisit))
+	})
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_CreateLinkVisits_Call) Return(_a0 error) *LinkVisitRepositoryMock_CreateLinkVisits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_CreateLinkVisits_Call) RunAndReturn(run func(context.Context, []*models.LinkVisit) error) *LinkVisitRepositoryMock_CreateLinkVisits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDestinationClicks provides a mock function with given fields: ctx, linkID, from, to
+func (_m *LinkVisitRepositoryMock) GetDestinationClicks(ctx context.Context, linkID string, from time.Time, to time.Time) (map[string]int, error) {
+	ret := _m.Called(ctx, linkID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDestinationClicks")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (map[string]int, error)); ok {
+		return rf(ctx, linkID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) map[string]int); ok {
+		r0 = rf(ctx, linkID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, linkID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkVisitRepositoryMock_GetDestinationClicks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDestinationClicks'
+type LinkVisitRepositoryMock_GetDestinationClicks_Call struct {
+	*mock.Call
+}
+
+// GetDestinationClicks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID string
+//   - from time.Time
+//   - to time.Time
+func (_e *LinkVisitRepositoryMock_Expecter) GetDestinationClicks(ctx interface{}, linkID interface{}, from interface{}, to interface{}) *LinkVisitRepositoryMock_GetDestinationClicks_Call {
+	return &LinkVisitRepositoryMock_GetDestinationClicks_Call{Call: _e.mock.On("GetDestinationClicks", ctx, linkID, from, to)}
+}
+
+func (_c *LinkVisitRepositoryMock_GetDestinationClicks_Call) Run(run func(ctx context.Context, linkID string, from time.Time, to time.Time)) *LinkVisitRepositoryMock_GetDestinationClicks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetDestinationClicks_Call) Return(_a0 map[string]int, _a1 error) *LinkVisitRepositoryMock_GetDestinationClicks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetDestinationClicks_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time) (map[string]int, error)) *LinkVisitRepositoryMock_GetDestinationClicks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTopCities provides a mock function with given fields: ctx, linkID, from, to, limit
+func (_m *LinkVisitRepositoryMock) GetTopCities(ctx context.Context, linkID string, from time.Time, to time.Time, limit int) ([]models.VisitLocation, error) {
+	ret := _m.Called(ctx, linkID, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopCities")
+	}
+
+	var r0 []models.VisitLocation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, int) ([]models.VisitLocation, error)); ok {
+		return rf(ctx, linkID, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, int) []models.VisitLocation); ok {
+		r0 = rf(ctx, linkID, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.VisitLocation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, linkID, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkVisitRepositoryMock_GetTopCities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopCities'
+type LinkVisitRepositoryMock_GetTopCities_Call struct {
+	*mock.Call
+}
+
+// GetTopCities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID string
+//   - from time.Time
+//   - to time.Time
+//   - limit int
+func (_e *LinkVisitRepositoryMock_Expecter) GetTopCities(ctx interface{}, linkID interface{}, from interface{}, to interface{}, limit interface{}) *LinkVisitRepositoryMock_GetTopCities_Call {
+	return &LinkVisitRepositoryMock_GetTopCities_Call{Call: _e.mock.On("GetTopCities", ctx, linkID, from, to, limit)}
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopCities_Call) Run(run func(ctx context.Context, linkID string, from time.Time, to time.Time, limit int)) *LinkVisitRepositoryMock_GetTopCities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopCities_Call) Return(_a0 []models.VisitLocation, _a1 error) *LinkVisitRepositoryMock_GetTopCities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopCities_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time, int) ([]models.VisitLocation, error)) *LinkVisitRepositoryMock_GetTopCities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTopCountries provides a mock function with given fields: ctx, linkID, from, to, limit
+func (_m *LinkVisitRepositoryMock) GetTopCountries(ctx context.Context, linkID string, from time.Time, to time.Time, limit int) ([]models.VisitCount, error) {
+	ret := _m.Called(ctx, linkID, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopCountries")
+	}
+
+	var r0 []models.VisitCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, int) ([]models.VisitCount, error)); ok {
+		return rf(ctx, linkID, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, int) []models.VisitCount); ok {
+		r0 = rf(ctx, linkID, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.VisitCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, linkID, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkVisitRepositoryMock_GetTopCountries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopCountries'
+type LinkVisitRepositoryMock_GetTopCountries_Call struct {
+	*mock.Call
+}
+
+// GetTopCountries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID string
+//   - from time.Time
+//   - to time.Time
+//   - limit int
+func (_e *LinkVisitRepositoryMock_Expecter) GetTopCountries(ctx interface{}, linkID interface{}, from interface{}, to interface{}, limit interface{}) *LinkVisitRepositoryMock_GetTopCountries_Call {
+	return &LinkVisitRepositoryMock_GetTopCountries_Call{Call: _e.mock.On("GetTopCountries", ctx, linkID, from, to, limit)}
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopCountries_Call) Run(run func(ctx context.Context, linkID string, from time.Time, to time.Time, limit int)) *LinkVisitRepositoryMock_GetTopCountries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopCountries_Call) Return(_a0 []models.VisitCount, _a1 error) *LinkVisitRepositoryMock_GetTopCountries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopCountries_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time, int) ([]models.VisitCount, error)) *LinkVisitRepositoryMock_GetTopCountries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTopReferrers provides a mock function with given fields: ctx, linkID, from, to, limit
+func (_m *LinkVisitRepositoryMock) GetTopReferrers(ctx context.Context, linkID string, from time.Time, to time.Time, limit int) ([]models.VisitCount, error) {
+	ret := _m.Called(ctx, linkID, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopReferrers")
+	}
+
+	var r0 []models.VisitCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, int) ([]models.VisitCount, error)); ok {
+		return rf(ctx, linkID, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, int) []models.VisitCount); ok {
+		r0 = rf(ctx, linkID, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.VisitCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, linkID, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkVisitRepositoryMock_GetTopReferrers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopReferrers'
+type LinkVisitRepositoryMock_GetTopReferrers_Call struct {
+	*mock.Call
+}
+
+// GetTopReferrers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID string
+//   - from time.Time
+//   - to time.Time
+//   - limit int
+func (_e *LinkVisitRepositoryMock_Expecter) GetTopReferrers(ctx interface{}, linkID interface{}, from interface{}, to interface{}, limit interface{}) *LinkVisitRepositoryMock_GetTopReferrers_Call {
+	return &LinkVisitRepositoryMock_GetTopReferrers_Call{Call: _e.mock.On("GetTopReferrers", ctx, linkID, from, to, limit)}
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopReferrers_Call) Run(run func(ctx context.Context, linkID string, from time.Time, to time.Time, limit int)) *LinkVisitRepositoryMock_GetTopReferrers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopReferrers_Call) Return(_a0 []models.VisitCount, _a1 error) *LinkVisitRepositoryMock_GetTopReferrers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopReferrers_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time, int) ([]models.VisitCount, error)) *LinkVisitRepositoryMock_GetTopReferrers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTopUserAgents provides a mock function with given fields: ctx, linkID, from, to, limit
+func (_m *LinkVisitRepositoryMock) GetTopUserAgents(ctx context.Context, linkID string, from time.Time, to time.Time, limit int) ([]models.VisitCount, error) {
+	ret := _m.Called(ctx, linkID, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopUserAgents")
+	}
+
+	var r0 []models.VisitCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, int) ([]models.VisitCount, error)); ok {
+		return rf(ctx, linkID, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, int) []models.VisitCount); ok {
+		r0 = rf(ctx, linkID, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.VisitCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, linkID, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkVisitRepositoryMock_GetTopUserAgents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopUserAgents'
+type LinkVisitRepositoryMock_GetTopUserAgents_Call struct {
+	*mock.Call
+}
+
+// GetTopUserAgents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID string
+//   - from time.Time
+//   - to time.Time
+//   - limit int
+func (_e *LinkVisitRepositoryMock_Expecter) GetTopUserAgents(ctx interface{}, linkID interface{}, from interface{}, to interface{}, limit interface{}) *LinkVisitRepositoryMock_GetTopUserAgents_Call {
+	return &LinkVisitRepositoryMock_GetTopUserAgents_Call{Call: _e.mock.On("GetTopUserAgents", ctx, linkID, from, to, limit)}
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopUserAgents_Call) Run(run func(ctx context.Context, linkID string, from time.Time, to time.Time, limit int)) *LinkVisitRepositoryMock_GetTopUserAgents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopUserAgents_Call) Return(_a0 []models.VisitCount, _a1 error) *LinkVisitRepositoryMock_GetTopUserAgents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetTopUserAgents_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time, int) ([]models.VisitCount, error)) *LinkVisitRepositoryMock_GetTopUserAgents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVisitSummary provides a mock function with given fields: ctx, linkID, from, to
+func (_m *LinkVisitRepositoryMock) GetVisitSummary(ctx context.Context, linkID string, from time.Time, to time.Time) (*models.VisitSummary, error) {
+	ret := _m.Called(ctx, linkID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVisitSummary")
+	}
+
+	var r0 *models.VisitSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (*models.VisitSummary, error)); ok {
+		return rf(ctx, linkID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) *models.VisitSummary); ok {
+		r0 = rf(ctx, linkID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VisitSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, linkID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkVisitRepositoryMock_GetVisitSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVisitSummary'
+type LinkVisitRepositoryMock_GetVisitSummary_Call struct {
+	*mock.Call
+}
+
+// GetVisitSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID string
+//   - from time.Time
+//   - to time.Time
+func (_e *LinkVisitRepositoryMock_Expecter) GetVisitSummary(ctx interface{}, linkID interface{}, from interface{}, to interface{}) *LinkVisitRepositoryMock_GetVisitSummary_Call {
+	return &LinkVisitRepositoryMock_GetVisitSummary_Call{Call: _e.mock.On("GetVisitSummary", ctx, linkID, from, to)}
+}
+
+func (_c *LinkVisitRepositoryMock_GetVisitSummary_Call) Run(run func(ctx context.Context, linkID string, from time.Time, to time.Time)) *LinkVisitRepositoryMock_GetVisitSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetVisitSummary_Call) Return(_a0 *models.VisitSummary, _a1 error) *LinkVisitRepositoryMock_GetVisitSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetVisitSummary_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time) (*models.VisitSummary, error)) *LinkVisitRepositoryMock_GetVisitSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVisitTimeline provides a mock function with given fields: ctx, linkID, from, to, bucket
+func (_m *LinkVisitRepositoryMock) GetVisitTimeline(ctx context.Context, linkID string, from time.Time, to time.Time, bucket models.StatsBucket) ([]models.VisitBucket, error) {
+	ret := _m.Called(ctx, linkID, from, to, bucket)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVisitTimeline")
+	}
+
+	var r0 []models.VisitBucket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, models.StatsBucket) ([]models.VisitBucket, error)); ok {
+		return rf(ctx, linkID, from, to, bucket)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, models.StatsBucket) []models.VisitBucket); ok {
+		r0 = rf(ctx, linkID, from, to, bucket)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.VisitBucket)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time, models.StatsBucket) error); ok {
+		r1 = rf(ctx, linkID, from, to, bucket)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkVisitRepositoryMock_GetVisitTimeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVisitTimeline'
+type LinkVisitRepositoryMock_GetVisitTimeline_Call struct {
+	*mock.Call
+}
+
+// GetVisitTimeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID string
+//   - from time.Time
+//   - to time.Time
+//   - bucket models.StatsBucket
+func (_e *LinkVisitRepositoryMock_Expecter) GetVisitTimeline(ctx interface{}, linkID interface{}, from interface{}, to interface{}, bucket interface{}) *LinkVisitRepositoryMock_GetVisitTimeline_Call {
+	return &LinkVisitRepositoryMock_GetVisitTimeline_Call{Call: _e.mock.On("GetVisitTimeline", ctx, linkID, from, to, bucket)}
+}
+
+func (_c *LinkVisitRepositoryMock_GetVisitTimeline_Call) Run(run func(ctx context.Context, linkID string, from time.Time, to time.Time, bucket models.StatsBucket)) *LinkVisitRepositoryMock_GetVisitTimeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(models.StatsBucket))
+	})
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetVisitTimeline_Call) Return(_a0 []models.VisitBucket, _a1 error) *LinkVisitRepositoryMock_GetVisitTimeline_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_GetVisitTimeline_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time, models.StatsBucket) ([]models.VisitBucket, error)) *LinkVisitRepositoryMock_GetVisitTimeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamVisits provides a mock function with given fields: ctx, linkID, query, fn
+func (_m *LinkVisitRepositoryMock) StreamVisits(ctx context.Context, linkID string, query models.VisitExportQuery, fn func(*models.LinkVisit) error) error {
+	ret := _m.Called(ctx, linkID, query, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamVisits")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.VisitExportQuery, func(*models.LinkVisit) error) error); ok {
+		r0 = rf(ctx, linkID, query, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LinkVisitRepositoryMock_StreamVisits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamVisits'
+type LinkVisitRepositoryMock_StreamVisits_Call struct {
+	*mock.Call
+}
+
+// StreamVisits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID string
+//   - query models.VisitExportQuery
+//   - fn func(*models.LinkVisit) error
+func (_e *LinkVisitRepositoryMock_Expecter) StreamVisits(ctx interface{}, linkID interface{}, query interface{}, fn interface{}) *LinkVisitRepositoryMock_StreamVisits_Call {
+	return &LinkVisitRepositoryMock_StreamVisits_Call{Call: _e.mock.On("StreamVisits", ctx, linkID, query, fn)}
+}
+
+func (_c *LinkVisitRepositoryMock_StreamVisits_Call) Run(run func(ctx context.Context, linkID string, query models.VisitExportQuery, fn func(*models.LinkVisit) error)) *LinkVisitRepositoryMock_StreamVisits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.VisitExportQuery), args[3].(func(*models.LinkVisit) error))
+	})
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_StreamVisits_Call) Return(_a0 error) *LinkVisitRepositoryMock_StreamVisits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LinkVisitRepositoryMock_StreamVisits_Call) RunAndReturn(run func(context.Context, string, models.VisitExportQuery, func(*models.LinkVisit) error) error) *LinkVisitRepositoryMock_StreamVisits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewLinkVisitRepositoryMock creates a new instance of LinkVisitRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLinkVisitRepositoryMock(t interface {
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/g-villarinho/link-fizz-api/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,17 +22,17 @@ func (_m *LinkVisitServiceMock) EXPECT() *LinkVisitServiceMock_Expecter {
 	return &LinkVisitServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// CreateLinkVisit provides a mock function with given fields: ctx, linkID, ipAddress, userAgent
-func (_m *LinkVisitServiceMock) CreateLinkVisit(ctx context.Context, linkID string, ipAddress string, userAgent string) error {
-	ret := _m.Called(ctx, linkID, ipAddress, userAgent)
+// CreateLinkVisit provides a mock function with given fields: ctx, link, request
+func (_m *LinkVisitServiceMock) CreateLinkVisit(ctx context.Context, link *models.Link, request models.RedirectRequest) error {
+	ret := _m.Called(ctx, link, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateLinkVisit")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = rf(ctx, linkID, ipAddress, userAgent)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Link, models.RedirectRequest) error); ok {
+		r0 = rf(ctx, link, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,16 +47,15 @@ type LinkVisitServiceMock_CreateLinkVisit_Call struct {
 
 // CreateLinkVisit is a helper method to define mock.On call
 //   - ctx context.Context
-//   - linkID string
-//   - ipAddress string
-//   - userAgent string
-func (_e *LinkVisitServiceMock_Expecter) CreateLinkVisit(ctx interface{}, linkID interface{}, ipAddress interface{}, userAgent interface{}) *LinkVisitServiceMock_CreateLinkVisit_Call {
-	return &LinkVisitServiceMock_CreateLinkVisit_Call{Call: _e.mock.On("CreateLinkVisit", ctx, linkID, ipAddress, userAgent)}
+//   - link *models.Link
+//   - request models.RedirectRequest
+func (_e *LinkVisitServiceMock_Expecter) CreateLinkVisit(ctx interface{}, link interface{}, request interface{}) *LinkVisitServiceMock_CreateLinkVisit_Call {
+	return &LinkVisitServiceMock_CreateLinkVisit_Call{Call: _e.mock.On("CreateLinkVisit", ctx, link, request)}
 }
 
-func (_c *LinkVisitServiceMock_CreateLinkVisit_Call) Run(run func(ctx context.Context, linkID string, ipAddress string, userAgent string)) *LinkVisitServiceMock_CreateLinkVisit_Call {
+func (_c *LinkVisitServiceMock_CreateLinkVisit_Call) Run(run func(ctx context.Context, link *models.Link, request models.RedirectRequest)) *LinkVisitServiceMock_CreateLinkVisit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(*models.Link), args[2].(models.RedirectRequest))
 	})
 	return _c
 }
@@ -65,7 +65,69 @@ func (_c *LinkVisitServiceMock_CreateLinkVisit_Call) Return(_a0 error) *LinkVisi
 	return _c
 }
 
-func (_c *LinkVisitServiceMock_CreateLinkVisit_Call) RunAndReturn(run func(context.Context, string, string, string) error) *LinkVisitServiceMock_CreateLinkVisit_Call {
+func (_c *LinkVisitServiceMock_CreateLinkVisit_Call) RunAndReturn(run func(context.Context, *models.Link, models.RedirectRequest) error) *LinkVisitServiceMock_CreateLinkVisit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLinkStats provides a mock function with given fields: ctx, userID, domain, shortCode, query
+func (_m *LinkVisitServiceMock) GetLinkStats(ctx context.Context, userID string, domain string, shortCode string, query models.LinkStatsQuery) (*models.LinkStatsResponse, error) {
+	ret := _m.Called(ctx, userID, domain, shortCode, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinkStats")
+	}
+
+	var r0 *models.LinkStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, models.LinkStatsQuery) (*models.LinkStatsResponse, error)); ok {
+		return rf(ctx, userID, domain, shortCode, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, models.LinkStatsQuery) *models.LinkStatsResponse); ok {
+		r0 = rf(ctx, userID, domain, shortCode, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LinkStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, models.LinkStatsQuery) error); ok {
+		r1 = rf(ctx, userID, domain, shortCode, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkVisitServiceMock_GetLinkStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinkStats'
+type LinkVisitServiceMock_GetLinkStats_Call struct {
+	*mock.Call
+}
+
+// GetLinkStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - domain string
+//   - shortCode string
+//   - query models.LinkStatsQuery
+func (_e *LinkVisitServiceMock_Expecter) GetLinkStats(ctx interface{}, userID interface{}, domain interface{}, shortCode interface{}, query interface{}) *LinkVisitServiceMock_GetLinkStats_Call {
+	return &LinkVisitServiceMock_GetLinkStats_Call{Call: _e.mock.On("GetLinkStats", ctx, userID, domain, shortCode, query)}
+}
+
+func (_c *LinkVisitServiceMock_GetLinkStats_Call) Run(run func(ctx context.Context, userID string, domain string, shortCode string, query models.LinkStatsQuery)) *LinkVisitServiceMock_GetLinkStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(models.LinkStatsQuery))
+	})
+	return _c
+}
+
+func (_c *LinkVisitServiceMock_GetLinkStats_Call) Return(_a0 *models.LinkStatsResponse, _a1 error) *LinkVisitServiceMock_GetLinkStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LinkVisitServiceMock_GetLinkStats_Call) RunAndReturn(run func(context.Context, string, string, string, models.LinkStatsQuery) (*models.LinkStatsResponse, error)) *LinkVisitServiceMock_GetLinkStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
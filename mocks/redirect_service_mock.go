@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/g-villarinho/link-fizz-api/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,27 +22,29 @@ func (_m *RedirectServiceMock) EXPECT() *RedirectServiceMock_Expecter {
 	return &RedirectServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// GetOriginalURLWithTracking provides a mock function with given fields: ctx, linkShortCode, userAgent, ipAddress
-func (_m *RedirectServiceMock) GetOriginalURLWithTracking(ctx context.Context, linkShortCode string, userAgent string, ipAddress string) (string, error) {
-	ret := _m.Called(ctx, linkShortCode, userAgent, ipAddress)
+// GetOriginalURLWithTracking provides a mock function with given fields: ctx, request
+func (_m *RedirectServiceMock) GetOriginalURLWithTracking(ctx context.Context, request models.RedirectRequest) (*models.RedirectResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetOriginalURLWithTracking")
 	}
 
-	var r0 string
+	var r0 *models.RedirectResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
-		return rf(ctx, linkShortCode, userAgent, ipAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, models.RedirectRequest) (*models.RedirectResponse, error)); ok {
+		return rf(ctx, request)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
-		r0 = rf(ctx, linkShortCode, userAgent, ipAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, models.RedirectRequest) *models.RedirectResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RedirectResponse)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, linkShortCode, userAgent, ipAddress)
+	if rf, ok := ret.Get(1).(func(context.Context, models.RedirectRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,26 +59,84 @@ type RedirectServiceMock_GetOriginalURLWithTracking_Call struct {
 
 // GetOriginalURLWithTracking is a helper method to define mock.On call
 //   - ctx context.Context
-//   - linkShortCode string
-//   - userAgent string
-//   - ipAddress string
-func (_e *RedirectServiceMock_Expecter) GetOriginalURLWithTracking(ctx interface{}, linkShortCode interface{}, userAgent interface{}, ipAddress interface{}) *RedirectServiceMock_GetOriginalURLWithTracking_Call {
-	return &RedirectServiceMock_GetOriginalURLWithTracking_Call{Call: _e.mock.On("GetOriginalURLWithTracking", ctx, linkShortCode, userAgent, ipAddress)}
+//   - request models.RedirectRequest
+func (_e *RedirectServiceMock_Expecter) GetOriginalURLWithTracking(ctx interface{}, request interface{}) *RedirectServiceMock_GetOriginalURLWithTracking_Call {
+	return &RedirectServiceMock_GetOriginalURLWithTracking_Call{Call: _e.mock.On("GetOriginalURLWithTracking", ctx, request)}
 }
 
-func (_c *RedirectServiceMock_GetOriginalURLWithTracking_Call) Run(run func(ctx context.Context, linkShortCode string, userAgent string, ipAddress string)) *RedirectServiceMock_GetOriginalURLWithTracking_Call {
+func (_c *RedirectServiceMock_GetOriginalURLWithTracking_Call) Run(run func(ctx context.Context, request models.RedirectRequest)) *RedirectServiceMock_GetOriginalURLWithTracking_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(models.RedirectRequest))
 	})
 	return _c
 }
 
-func (_c *RedirectServiceMock_GetOriginalURLWithTracking_Call) Return(_a0 string, _a1 error) *RedirectServiceMock_GetOriginalURLWithTracking_Call {
+func (_c *RedirectServiceMock_GetOriginalURLWithTracking_Call) Return(_a0 *models.RedirectResponse, _a1 error) *RedirectServiceMock_GetOriginalURLWithTracking_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RedirectServiceMock_GetOriginalURLWithTracking_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *RedirectServiceMock_GetOriginalURLWithTracking_Call {
+func (_c *RedirectServiceMock_GetOriginalURLWithTracking_Call) RunAndReturn(run func(context.Context, models.RedirectRequest) (*models.RedirectResponse, error)) *RedirectServiceMock_GetOriginalURLWithTracking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlockLink provides a mock function with given fields: ctx, request, password
+func (_m *RedirectServiceMock) UnlockLink(ctx context.Context, request models.RedirectRequest, password string) (*models.UnlockLinkResponse, error) {
+	ret := _m.Called(ctx, request, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockLink")
+	}
+
+	var r0 *models.UnlockLinkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.RedirectRequest, string) (*models.UnlockLinkResponse, error)); ok {
+		return rf(ctx, request, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.RedirectRequest, string) *models.UnlockLinkResponse); ok {
+		r0 = rf(ctx, request, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UnlockLinkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.RedirectRequest, string) error); ok {
+		r1 = rf(ctx, request, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedirectServiceMock_UnlockLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockLink'
+type RedirectServiceMock_UnlockLink_Call struct {
+	*mock.Call
+}
+
+// UnlockLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request models.RedirectRequest
+//   - password string
+func (_e *RedirectServiceMock_Expecter) UnlockLink(ctx interface{}, request interface{}, password interface{}) *RedirectServiceMock_UnlockLink_Call {
+	return &RedirectServiceMock_UnlockLink_Call{Call: _e.mock.On("UnlockLink", ctx, request, password)}
+}
+
+func (_c *RedirectServiceMock_UnlockLink_Call) Run(run func(ctx context.Context, request models.RedirectRequest, password string)) *RedirectServiceMock_UnlockLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.RedirectRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RedirectServiceMock_UnlockLink_Call) Return(_a0 *models.UnlockLinkResponse, _a1 error) *RedirectServiceMock_UnlockLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RedirectServiceMock_UnlockLink_Call) RunAndReturn(run func(context.Context, models.RedirectRequest, string) (*models.UnlockLinkResponse, error)) *RedirectServiceMock_UnlockLink_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,10 @@ import (
 
 	models "github.com/g-villarinho/link-fizz-api/models"
 	mock "github.com/stretchr/testify/mock"
+
+	sql "database/sql"
+
+	time "time"
 )
 
 // SessionRepositoryMock is an autogenerated mock type for the SessionRepository type
@@ -116,6 +120,301 @@ func (_c *SessionRepositoryMock_DeleteSession_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetRefreshTokenRetiredAt provides a mock function with given fields: ctx, sessionID, refreshTokenHash
+func (_m *SessionRepositoryMock) GetRefreshTokenRetiredAt(ctx context.Context, sessionID string, refreshTokenHash string) (sql.NullTime, error) {
+	ret := _m.Called(ctx, sessionID, refreshTokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshTokenRetiredAt")
+	}
+
+	var r0 sql.NullTime
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (sql.NullTime, error)); ok {
+		return rf(ctx, sessionID, refreshTokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) sql.NullTime); ok {
+		r0 = rf(ctx, sessionID, refreshTokenHash)
+	} else {
+		r0 = ret.Get(0).(sql.NullTime)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, sessionID, refreshTokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepositoryMock_GetRefreshTokenRetiredAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshTokenRetiredAt'
+type SessionRepositoryMock_GetRefreshTokenRetiredAt_Call struct {
+	*mock.Call
+}
+
+// GetRefreshTokenRetiredAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+//   - refreshTokenHash string
+func (_e *SessionRepositoryMock_Expecter) GetRefreshTokenRetiredAt(ctx interface{}, sessionID interface{}, refreshTokenHash interface{}) *SessionRepositoryMock_GetRefreshTokenRetiredAt_Call {
+	return &SessionRepositoryMock_GetRefreshTokenRetiredAt_Call{Call: _e.mock.On("GetRefreshTokenRetiredAt", ctx, sessionID, refreshTokenHash)}
+}
+
+func (_c *SessionRepositoryMock_GetRefreshTokenRetiredAt_Call) Run(run func(ctx context.Context, sessionID string, refreshTokenHash string)) *SessionRepositoryMock_GetRefreshTokenRetiredAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_GetRefreshTokenRetiredAt_Call) Return(_a0 sql.NullTime, _a1 error) *SessionRepositoryMock_GetRefreshTokenRetiredAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_GetRefreshTokenRetiredAt_Call) RunAndReturn(run func(context.Context, string, string) (sql.NullTime, error)) *SessionRepositoryMock_GetRefreshTokenRetiredAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessionByID provides a mock function with given fields: ctx, sessionID
+func (_m *SessionRepositoryMock) GetSessionByID(ctx context.Context, sessionID string) (*models.Session, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionByID")
+	}
+
+	var r0 *models.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Session, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Session); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepositoryMock_GetSessionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionByID'
+type SessionRepositoryMock_GetSessionByID_Call struct {
+	*mock.Call
+}
+
+// GetSessionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *SessionRepositoryMock_Expecter) GetSessionByID(ctx interface{}, sessionID interface{}) *SessionRepositoryMock_GetSessionByID_Call {
+	return &SessionRepositoryMock_GetSessionByID_Call{Call: _e.mock.On("GetSessionByID", ctx, sessionID)}
+}
+
+func (_c *SessionRepositoryMock_GetSessionByID_Call) Run(run func(ctx context.Context, sessionID string)) *SessionRepositoryMock_GetSessionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_GetSessionByID_Call) Return(_a0 *models.Session, _a1 error) *SessionRepositoryMock_GetSessionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_GetSessionByID_Call) RunAndReturn(run func(context.Context, string) (*models.Session, error)) *SessionRepositoryMock_GetSessionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessionState provides a mock function with given fields: ctx, sessionID
+func (_m *SessionRepositoryMock) GetSessionState(ctx context.Context, sessionID string) (*models.SessionState, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionState")
+	}
+
+	var r0 *models.SessionState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.SessionState, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.SessionState); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SessionState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepositoryMock_GetSessionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionState'
+type SessionRepositoryMock_GetSessionState_Call struct {
+	*mock.Call
+}
+
+// GetSessionState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *SessionRepositoryMock_Expecter) GetSessionState(ctx interface{}, sessionID interface{}) *SessionRepositoryMock_GetSessionState_Call {
+	return &SessionRepositoryMock_GetSessionState_Call{Call: _e.mock.On("GetSessionState", ctx, sessionID)}
+}
+
+func (_c *SessionRepositoryMock_GetSessionState_Call) Run(run func(ctx context.Context, sessionID string)) *SessionRepositoryMock_GetSessionState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_GetSessionState_Call) Return(_a0 *models.SessionState, _a1 error) *SessionRepositoryMock_GetSessionState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_GetSessionState_Call) RunAndReturn(run func(context.Context, string) (*models.SessionState, error)) *SessionRepositoryMock_GetSessionState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessionsByUserID provides a mock function with given fields: ctx, userID, activeAt
+func (_m *SessionRepositoryMock) GetSessionsByUserID(ctx context.Context, userID string, activeAt time.Time) ([]models.Session, error) {
+	ret := _m.Called(ctx, userID, activeAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionsByUserID")
+	}
+
+	var r0 []models.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]models.Session, error)); ok {
+		return rf(ctx, userID, activeAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []models.Session); ok {
+		r0 = rf(ctx, userID, activeAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, activeAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepositoryMock_GetSessionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionsByUserID'
+type SessionRepositoryMock_GetSessionsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetSessionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - activeAt time.Time
+func (_e *SessionRepositoryMock_Expecter) GetSessionsByUserID(ctx interface{}, userID interface{}, activeAt interface{}) *SessionRepositoryMock_GetSessionsByUserID_Call {
+	return &SessionRepositoryMock_GetSessionsByUserID_Call{Call: _e.mock.On("GetSessionsByUserID", ctx, userID, activeAt)}
+}
+
+func (_c *SessionRepositoryMock_GetSessionsByUserID_Call) Run(run func(ctx context.Context, userID string, activeAt time.Time)) *SessionRepositoryMock_GetSessionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_GetSessionsByUserID_Call) Return(_a0 []models.Session, _a1 error) *SessionRepositoryMock_GetSessionsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_GetSessionsByUserID_Call) RunAndReturn(run func(context.Context, string, time.Time) ([]models.Session, error)) *SessionRepositoryMock_GetSessionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSession provides a mock function with given fields: ctx, session, previousRefreshTokenHash, retiredBefore
+func (_m *SessionRepositoryMock) RotateSession(ctx context.Context, session *models.Session, previousRefreshTokenHash string, retiredBefore time.Time) (bool, error) {
+	ret := _m.Called(ctx, session, previousRefreshTokenHash, retiredBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSession")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Session, string, time.Time) (bool, error)); ok {
+		return rf(ctx, session, previousRefreshTokenHash, retiredBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Session, string, time.Time) bool); ok {
+		r0 = rf(ctx, session, previousRefreshTokenHash, retiredBefore)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Session, string, time.Time) error); ok {
+		r1 = rf(ctx, session, previousRefreshTokenHash, retiredBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepositoryMock_RotateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSession'
+type SessionRepositoryMock_RotateSession_Call struct {
+	*mock.Call
+}
+
+// RotateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *models.Session
+//   - previousRefreshTokenHash string
+//   - retiredBefore time.Time
+func (_e *SessionRepositoryMock_Expecter) RotateSession(ctx interface{}, session interface{}, previousRefreshTokenHash interface{}, retiredBefore interface{}) *SessionRepositoryMock_RotateSession_Call {
+	return &SessionRepositoryMock_RotateSession_Call{Call: _e.mock.On("RotateSession", ctx, session, previousRefreshTokenHash, retiredBefore)}
+}
+
+func (_c *SessionRepositoryMock_RotateSession_Call) Run(run func(ctx context.Context, session *models.Session, previousRefreshTokenHash string, retiredBefore time.Time)) *SessionRepositoryMock_RotateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Session), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_RotateSession_Call) Return(_a0 bool, _a1 error) *SessionRepositoryMock_RotateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_RotateSession_Call) RunAndReturn(run func(context.Context, *models.Session, string, time.Time) (bool, error)) *SessionRepositoryMock_RotateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSessionRepositoryMock creates a new instance of SessionRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSessionRepositoryMock(t interface {
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/g-villarinho/link-fizz-api/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,22 +23,24 @@ func (_m *SessionServiceMock) EXPECT() *SessionServiceMock_Expecter {
 }
 
 // CreateSession provides a mock function with given fields: ctx, userID, IPAddress, userAgent
-func (_m *SessionServiceMock) CreateSession(ctx context.Context, userID string, IPAddress string, userAgent string) (string, error) {
+func (_m *SessionServiceMock) CreateSession(ctx context.Context, userID string, IPAddress string, userAgent string) (*models.LoginResponse, error) {
 	ret := _m.Called(ctx, userID, IPAddress, userAgent)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateSession")
 	}
 
-	var r0 string
+	var r0 *models.LoginResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.LoginResponse, error)); ok {
 		return rf(ctx, userID, IPAddress, userAgent)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.LoginResponse); ok {
 		r0 = rf(ctx, userID, IPAddress, userAgent)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoginResponse)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
@@ -70,12 +73,12 @@ func (_c *SessionServiceMock_CreateSession_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *SessionServiceMock_CreateSession_Call) Return(_a0 string, _a1 error) *SessionServiceMock_CreateSession_Call {
+func (_c *SessionServiceMock_CreateSession_Call) Return(_a0 *models.LoginResponse, _a1 error) *SessionServiceMock_CreateSession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SessionServiceMock_CreateSession_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *SessionServiceMock_CreateSession_Call {
+func (_c *SessionServiceMock_CreateSession_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.LoginResponse, error)) *SessionServiceMock_CreateSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -127,6 +130,283 @@ func (_c *SessionServiceMock_DeleteSession_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetUserSessions provides a mock function with given fields: ctx, userID, currentSessionID
+func (_m *SessionServiceMock) GetUserSessions(ctx context.Context, userID string, currentSessionID string) ([]models.SessionResponse, error) {
+	ret := _m.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSessions")
+	}
+
+	var r0 []models.SessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.SessionResponse, error)); ok {
+		return rf(ctx, userID, currentSessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.SessionResponse); ok {
+		r0 = rf(ctx, userID, currentSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionServiceMock_GetUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSessions'
+type SessionServiceMock_GetUserSessions_Call struct {
+	*mock.Call
+}
+
+// GetUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - currentSessionID string
+func (_e *SessionServiceMock_Expecter) GetUserSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *SessionServiceMock_GetUserSessions_Call {
+	return &SessionServiceMock_GetUserSessions_Call{Call: _e.mock.On("GetUserSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *SessionServiceMock_GetUserSessions_Call) Run(run func(ctx context.Context, userID string, currentSessionID string)) *SessionServiceMock_GetUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SessionServiceMock_GetUserSessions_Call) Return(_a0 []models.SessionResponse, _a1 error) *SessionServiceMock_GetUserSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionServiceMock_GetUserSessions_Call) RunAndReturn(run func(context.Context, string, string) ([]models.SessionResponse, error)) *SessionServiceMock_GetUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshSession provides a mock function with given fields: ctx, refreshToken, IPAddress, userAgent
+func (_m *SessionServiceMock) RefreshSession(ctx context.Context, refreshToken string, IPAddress string, userAgent string) (*models.LoginResponse, error) {
+	ret := _m.Called(ctx, refreshToken, IPAddress, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSession")
+	}
+
+	var r0 *models.LoginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.LoginResponse, error)); ok {
+		return rf(ctx, refreshToken, IPAddress, userAgent)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.LoginResponse); ok {
+		r0 = rf(ctx, refreshToken, IPAddress, userAgent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, refreshToken, IPAddress, userAgent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionServiceMock_RefreshSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshSession'
+type SessionServiceMock_RefreshSession_Call struct {
+	*mock.Call
+}
+
+// RefreshSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+//   - IPAddress string
+//   - userAgent string
+func (_e *SessionServiceMock_Expecter) RefreshSession(ctx interface{}, refreshToken interface{}, IPAddress interface{}, userAgent interface{}) *SessionServiceMock_RefreshSession_Call {
+	return &SessionServiceMock_RefreshSession_Call{Call: _e.mock.On("RefreshSession", ctx, refreshToken, IPAddress, userAgent)}
+}
+
+func (_c *SessionServiceMock_RefreshSession_Call) Run(run func(ctx context.Context, refreshToken string, IPAddress string, userAgent string)) *SessionServiceMock_RefreshSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *SessionServiceMock_RefreshSession_Call) Return(_a0 *models.LoginResponse, _a1 error) *SessionServiceMock_RefreshSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionServiceMock_RefreshSession_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.LoginResponse, error)) *SessionServiceMock_RefreshSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeOtherSessions provides a mock function with given fields: ctx, userID, currentSessionID
+func (_m *SessionServiceMock) RevokeOtherSessions(ctx context.Context, userID string, currentSessionID string) (*models.RevokeSessionsResponse, error) {
+	ret := _m.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOtherSessions")
+	}
+
+	var r0 *models.RevokeSessionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.RevokeSessionsResponse, error)); ok {
+		return rf(ctx, userID, currentSessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.RevokeSessionsResponse); ok {
+		r0 = rf(ctx, userID, currentSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RevokeSessionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionServiceMock_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type SessionServiceMock_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - currentSessionID string
+func (_e *SessionServiceMock_Expecter) RevokeOtherSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *SessionServiceMock_RevokeOtherSessions_Call {
+	return &SessionServiceMock_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *SessionServiceMock_RevokeOtherSessions_Call) Run(run func(ctx context.Context, userID string, currentSessionID string)) *SessionServiceMock_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SessionServiceMock_RevokeOtherSessions_Call) Return(_a0 *models.RevokeSessionsResponse, _a1 error) *SessionServiceMock_RevokeOtherSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionServiceMock_RevokeOtherSessions_Call) RunAndReturn(run func(context.Context, string, string) (*models.RevokeSessionsResponse, error)) *SessionServiceMock_RevokeOtherSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserSession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *SessionServiceMock) RevokeUserSession(ctx context.Context, userID string, sessionID string) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionServiceMock_RevokeUserSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserSession'
+type SessionServiceMock_RevokeUserSession_Call struct {
+	*mock.Call
+}
+
+// RevokeUserSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - sessionID string
+func (_e *SessionServiceMock_Expecter) RevokeUserSession(ctx interface{}, userID interface{}, sessionID interface{}) *SessionServiceMock_RevokeUserSession_Call {
+	return &SessionServiceMock_RevokeUserSession_Call{Call: _e.mock.On("RevokeUserSession", ctx, userID, sessionID)}
+}
+
+func (_c *SessionServiceMock_RevokeUserSession_Call) Run(run func(ctx context.Context, userID string, sessionID string)) *SessionServiceMock_RevokeUserSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SessionServiceMock_RevokeUserSession_Call) Return(_a0 error) *SessionServiceMock_RevokeUserSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionServiceMock_RevokeUserSession_Call) RunAndReturn(run func(context.Context, string, string) error) *SessionServiceMock_RevokeUserSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateSession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *SessionServiceMock) ValidateSession(ctx context.Context, userID string, sessionID string) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionServiceMock_ValidateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateSession'
+type SessionServiceMock_ValidateSession_Call struct {
+	*mock.Call
+}
+
+// ValidateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - sessionID string
+func (_e *SessionServiceMock_Expecter) ValidateSession(ctx interface{}, userID interface{}, sessionID interface{}) *SessionServiceMock_ValidateSession_Call {
+	return &SessionServiceMock_ValidateSession_Call{Call: _e.mock.On("ValidateSession", ctx, userID, sessionID)}
+}
+
+func (_c *SessionServiceMock_ValidateSession_Call) Run(run func(ctx context.Context, userID string, sessionID string)) *SessionServiceMock_ValidateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SessionServiceMock_ValidateSession_Call) Return(_a0 error) *SessionServiceMock_ValidateSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionServiceMock_ValidateSession_Call) RunAndReturn(run func(context.Context, string, string) error) *SessionServiceMock_ValidateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSessionServiceMock creates a new instance of SessionServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSessionServiceMock(t interface {
@@ -24,6 +24,125 @@ func (_m *TokenServiceMock) EXPECT() *TokenServiceMock_Expecter {
 	return &TokenServiceMock_Expecter{mock: &_m.Mock}
 }
 
+// GenerateEmailVerificationToken provides a mock function with given fields: ctx, userID, email, iat, exp
+func (_m *TokenServiceMock) GenerateEmailVerificationToken(ctx context.Context, userID string, email string, iat time.Time, exp time.Time) (string, error) {
+	ret := _m.Called(ctx, userID, email, iat, exp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateEmailVerificationToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) (string, error)); ok {
+		return rf(ctx, userID, email, iat, exp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) string); ok {
+		r0 = rf(ctx, userID, email, iat, exp)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, email, iat, exp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenServiceMock_GenerateEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateEmailVerificationToken'
+type TokenServiceMock_GenerateEmailVerificationToken_Call struct {
+	*mock.Call
+}
+
+// GenerateEmailVerificationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - email string
+//   - iat time.Time
+//   - exp time.Time
+func (_e *TokenServiceMock_Expecter) GenerateEmailVerificationToken(ctx interface{}, userID interface{}, email interface{}, iat interface{}, exp interface{}) *TokenServiceMock_GenerateEmailVerificationToken_Call {
+	return &TokenServiceMock_GenerateEmailVerificationToken_Call{Call: _e.mock.On("GenerateEmailVerificationToken", ctx, userID, email, iat, exp)}
+}
+
+func (_c *TokenServiceMock_GenerateEmailVerificationToken_Call) Run(run func(ctx context.Context, userID string, email string, iat time.Time, exp time.Time)) *TokenServiceMock_GenerateEmailVerificationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TokenServiceMock_GenerateEmailVerificationToken_Call) Return(_a0 string, _a1 error) *TokenServiceMock_GenerateEmailVerificationToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenServiceMock_GenerateEmailVerificationToken_Call) RunAndReturn(run func(context.Context, string, string, time.Time, time.Time) (string, error)) *TokenServiceMock_GenerateEmailVerificationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateLinkAccessToken provides a mock function with given fields: ctx, linkID, iat, exp
+func (_m *TokenServiceMock) GenerateLinkAccessToken(ctx context.Context, linkID string, iat time.Time, exp time.Time) (string, error) {
+	ret := _m.Called(ctx, linkID, iat, exp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateLinkAccessToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (string, error)); ok {
+		return rf(ctx, linkID, iat, exp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) string); ok {
+		r0 = rf(ctx, linkID, iat, exp)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, linkID, iat, exp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenServiceMock_GenerateLinkAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateLinkAccessToken'
+type TokenServiceMock_GenerateLinkAccessToken_Call struct {
+	*mock.Call
+}
+
+// GenerateLinkAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID string
+//   - iat time.Time
+//   - exp time.Time
+func (_e *TokenServiceMock_Expecter) GenerateLinkAccessToken(ctx interface{}, linkID interface{}, iat interface{}, exp interface{}) *TokenServiceMock_GenerateLinkAccessToken_Call {
+	return &TokenServiceMock_GenerateLinkAccessToken_Call{Call: _e.mock.On("GenerateLinkAccessToken", ctx, linkID, iat, exp)}
+}
+
+func (_c *TokenServiceMock_GenerateLinkAccessToken_Call) Run(run func(ctx context.Context, linkID string, iat time.Time, exp time.Time)) *TokenServiceMock_GenerateLinkAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TokenServiceMock_GenerateLinkAccessToken_Call) Return(_a0 string, _a1 error) *TokenServiceMock_GenerateLinkAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenServiceMock_GenerateLinkAccessToken_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time) (string, error)) *TokenServiceMock_GenerateLinkAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateToken provides a mock function with given fields: ctx, userID, sessionID, iat, exp
 func (_m *TokenServiceMock) GenerateToken(ctx context.Context, userID string, sessionID string, iat time.Time, exp time.Time) (string, error) {
 	ret := _m.Called(ctx, userID, sessionID, iat, exp)
@@ -84,6 +203,113 @@ func (_c *TokenServiceMock_GenerateToken_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ValidateEmailVerificationToken provides a mock function with given fields: ctx, tokenString
+func (_m *TokenServiceMock) ValidateEmailVerificationToken(ctx context.Context, tokenString string) (*models.EmailVerificationClaims, error) {
+	ret := _m.Called(ctx, tokenString)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateEmailVerificationToken")
+	}
+
+	var r0 *models.EmailVerificationClaims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.EmailVerificationClaims, error)); ok {
+		return rf(ctx, tokenString)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.EmailVerificationClaims); ok {
+		r0 = rf(ctx, tokenString)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.EmailVerificationClaims)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenString)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenServiceMock_ValidateEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateEmailVerificationToken'
+type TokenServiceMock_ValidateEmailVerificationToken_Call struct {
+	*mock.Call
+}
+
+// ValidateEmailVerificationToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenString string
+func (_e *TokenServiceMock_Expecter) ValidateEmailVerificationToken(ctx interface{}, tokenString interface{}) *TokenServiceMock_ValidateEmailVerificationToken_Call {
+	return &TokenServiceMock_ValidateEmailVerificationToken_Call{Call: _e.mock.On("ValidateEmailVerificationToken", ctx, tokenString)}
+}
+
+func (_c *TokenServiceMock_ValidateEmailVerificationToken_Call) Run(run func(ctx context.Context, tokenString string)) *TokenServiceMock_ValidateEmailVerificationToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TokenServiceMock_ValidateEmailVerificationToken_Call) Return(_a0 *models.EmailVerificationClaims, _a1 error) *TokenServiceMock_ValidateEmailVerificationToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenServiceMock_ValidateEmailVerificationToken_Call) RunAndReturn(run func(context.Context, string) (*models.EmailVerificationClaims, error)) *TokenServiceMock_ValidateEmailVerificationToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateLinkAccessToken provides a mock function with given fields: ctx, tokenString, linkID
+func (_m *TokenServiceMock) ValidateLinkAccessToken(ctx context.Context, tokenString string, linkID string) error {
+	ret := _m.Called(ctx, tokenString, linkID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateLinkAccessToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tokenString, linkID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenServiceMock_ValidateLinkAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateLinkAccessToken'
+type TokenServiceMock_ValidateLinkAccessToken_Call struct {
+	*mock.Call
+}
+
+// ValidateLinkAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenString string
+//   - linkID string
+func (_e *TokenServiceMock_Expecter) ValidateLinkAccessToken(ctx interface{}, tokenString interface{}, linkID interface{}) *TokenServiceMock_ValidateLinkAccessToken_Call {
+	return &TokenServiceMock_ValidateLinkAccessToken_Call{Call: _e.mock.On("ValidateLinkAccessToken", ctx, tokenString, linkID)}
+}
+
+func (_c *TokenServiceMock_ValidateLinkAccessToken_Call) Run(run func(ctx context.Context, tokenString string, linkID string)) *TokenServiceMock_ValidateLinkAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TokenServiceMock_ValidateLinkAccessToken_Call) Return(_a0 error) *TokenServiceMock_ValidateLinkAccessToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenServiceMock_ValidateLinkAccessToken_Call) RunAndReturn(run func(context.Context, string, string) error) *TokenServiceMock_ValidateLinkAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateToken provides a mock function with given fields: ctx, tokenString
 func (_m *TokenServiceMock) ValidateToken(ctx context.Context, tokenString string) (*models.TokenClaims, error) {
 	ret := _m.Called(ctx, tokenString)
@@ -89,6 +89,74 @@ func (_c *UserHandlerMock_GetProfile_Call) RunAndReturn(run func(http.ResponseWr
 	return _c
 }
 
+// UpdatePassword provides a mock function with given fields: w, r
+func (_m *UserHandlerMock) UpdatePassword(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// UserHandlerMock_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type UserHandlerMock_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *UserHandlerMock_Expecter) UpdatePassword(w interface{}, r interface{}) *UserHandlerMock_UpdatePassword_Call {
+	return &UserHandlerMock_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", w, r)}
+}
+
+func (_c *UserHandlerMock_UpdatePassword_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *UserHandlerMock_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *UserHandlerMock_UpdatePassword_Call) Return() *UserHandlerMock_UpdatePassword_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserHandlerMock_UpdatePassword_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *UserHandlerMock_UpdatePassword_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdatePreferences provides a mock function with given fields: w, r
+func (_m *UserHandlerMock) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	_m.Called(w, r)
+}
+
+// UserHandlerMock_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type UserHandlerMock_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *UserHandlerMock_Expecter) UpdatePreferences(w interface{}, r interface{}) *UserHandlerMock_UpdatePreferences_Call {
+	return &UserHandlerMock_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", w, r)}
+}
+
+func (_c *UserHandlerMock_UpdatePreferences_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *UserHandlerMock_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *UserHandlerMock_UpdatePreferences_Call) Return() *UserHandlerMock_UpdatePreferences_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserHandlerMock_UpdatePreferences_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *UserHandlerMock_UpdatePreferences_Call {
+	_c.Run(run)
+	return _c
+}
+
 // UpdateUser provides a mock function with given fields: w, r
 func (_m *UserHandlerMock) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	_m.Called(w, r)
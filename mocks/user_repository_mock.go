@@ -7,6 +7,8 @@ import (
 
 	models "github.com/g-villarinho/link-fizz-api/models"
 	mock "github.com/stretchr/testify/mock"
+
+	sql "database/sql"
 )
 
 // UserRepositoryMock is an autogenerated mock type for the UserRepository type
@@ -234,6 +236,54 @@ func (_c *UserRepositoryMock_GetUserByID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// UpdateDisabledAt provides a mock function with given fields: ctx, id, disabledAt
+func (_m *UserRepositoryMock) UpdateDisabledAt(ctx context.Context, id string, disabledAt sql.NullTime) error {
+	ret := _m.Called(ctx, id, disabledAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDisabledAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, sql.NullTime) error); ok {
+		r0 = rf(ctx, id, disabledAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepositoryMock_UpdateDisabledAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDisabledAt'
+type UserRepositoryMock_UpdateDisabledAt_Call struct {
+	*mock.Call
+}
+
+// UpdateDisabledAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - disabledAt sql.NullTime
+func (_e *UserRepositoryMock_Expecter) UpdateDisabledAt(ctx interface{}, id interface{}, disabledAt interface{}) *UserRepositoryMock_UpdateDisabledAt_Call {
+	return &UserRepositoryMock_UpdateDisabledAt_Call{Call: _e.mock.On("UpdateDisabledAt", ctx, id, disabledAt)}
+}
+
+func (_c *UserRepositoryMock_UpdateDisabledAt_Call) Run(run func(ctx context.Context, id string, disabledAt sql.NullTime)) *UserRepositoryMock_UpdateDisabledAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(sql.NullTime))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdateDisabledAt_Call) Return(_a0 error) *UserRepositoryMock_UpdateDisabledAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdateDisabledAt_Call) RunAndReturn(run func(context.Context, string, sql.NullTime) error) *UserRepositoryMock_UpdateDisabledAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function with given fields: ctx, user
 func (_m *UserRepositoryMock) UpdateUser(ctx context.Context, user *models.User) error {
 	ret := _m.Called(ctx, user)
@@ -129,6 +129,100 @@ func (_c *UserServiceMock_DeleteUser_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DisableUser provides a mock function with given fields: ctx, ID
+func (_m *UserServiceMock) DisableUser(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserServiceMock_DisableUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableUser'
+type UserServiceMock_DisableUser_Call struct {
+	*mock.Call
+}
+
+// DisableUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *UserServiceMock_Expecter) DisableUser(ctx interface{}, ID interface{}) *UserServiceMock_DisableUser_Call {
+	return &UserServiceMock_DisableUser_Call{Call: _e.mock.On("DisableUser", ctx, ID)}
+}
+
+func (_c *UserServiceMock_DisableUser_Call) Run(run func(ctx context.Context, ID string)) *UserServiceMock_DisableUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserServiceMock_DisableUser_Call) Return(_a0 error) *UserServiceMock_DisableUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserServiceMock_DisableUser_Call) RunAndReturn(run func(context.Context, string) error) *UserServiceMock_DisableUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableUser provides a mock function with given fields: ctx, ID
+func (_m *UserServiceMock) EnableUser(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserServiceMock_EnableUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableUser'
+type UserServiceMock_EnableUser_Call struct {
+	*mock.Call
+}
+
+// EnableUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *UserServiceMock_Expecter) EnableUser(ctx interface{}, ID interface{}) *UserServiceMock_EnableUser_Call {
+	return &UserServiceMock_EnableUser_Call{Call: _e.mock.On("EnableUser", ctx, ID)}
+}
+
+func (_c *UserServiceMock_EnableUser_Call) Run(run func(ctx context.Context, ID string)) *UserServiceMock_EnableUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserServiceMock_EnableUser_Call) Return(_a0 error) *UserServiceMock_EnableUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserServiceMock_EnableUser_Call) RunAndReturn(run func(context.Context, string) error) *UserServiceMock_EnableUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByEmail provides a mock function with given fields: ctx, email
 func (_m *UserServiceMock) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
 	ret := _m.Called(ctx, email)
@@ -247,6 +341,115 @@ func (_c *UserServiceMock_GetUserByID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// UpdatePassword provides a mock function with given fields: ctx, userID, currentPassword, newPassword
+func (_m *UserServiceMock) UpdatePassword(ctx context.Context, userID string, currentPassword string, newPassword string) error {
+	ret := _m.Called(ctx, userID, currentPassword, newPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, userID, currentPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserServiceMock_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type UserServiceMock_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - currentPassword string
+//   - newPassword string
+func (_e *UserServiceMock_Expecter) UpdatePassword(ctx interface{}, userID interface{}, currentPassword interface{}, newPassword interface{}) *UserServiceMock_UpdatePassword_Call {
+	return &UserServiceMock_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, userID, currentPassword, newPassword)}
+}
+
+func (_c *UserServiceMock_UpdatePassword_Call) Run(run func(ctx context.Context, userID string, currentPassword string, newPassword string)) *UserServiceMock_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *UserServiceMock_UpdatePassword_Call) Return(_a0 error) *UserServiceMock_UpdatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserServiceMock_UpdatePassword_Call) RunAndReturn(run func(context.Context, string, string, string) error) *UserServiceMock_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreferences provides a mock function with given fields: ctx, userID, payload
+func (_m *UserServiceMock) UpdatePreferences(ctx context.Context, userID string, payload models.UpdatePreferencesPayload) (*models.UserResponse, error) {
+	ret := _m.Called(ctx, userID, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 *models.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.UpdatePreferencesPayload) (*models.UserResponse, error)); ok {
+		return rf(ctx, userID, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.UpdatePreferencesPayload) *models.UserResponse); ok {
+		r0 = rf(ctx, userID, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.UpdatePreferencesPayload) error); ok {
+		r1 = rf(ctx, userID, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserServiceMock_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type UserServiceMock_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - payload models.UpdatePreferencesPayload
+func (_e *UserServiceMock_Expecter) UpdatePreferences(ctx interface{}, userID interface{}, payload interface{}) *UserServiceMock_UpdatePreferences_Call {
+	return &UserServiceMock_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", ctx, userID, payload)}
+}
+
+func (_c *UserServiceMock_UpdatePreferences_Call) Run(run func(ctx context.Context, userID string, payload models.UpdatePreferencesPayload)) *UserServiceMock_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.UpdatePreferencesPayload))
+	})
+	return _c
+}
+
+func (_c *UserServiceMock_UpdatePreferences_Call) Return(_a0 *models.UserResponse, _a1 error) *UserServiceMock_UpdatePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserServiceMock_UpdatePreferences_Call) RunAndReturn(run func(context.Context, string, models.UpdatePreferencesPayload) (*models.UserResponse, error)) *UserServiceMock_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function with given fields: ctx, ID, name, email
 func (_m *UserServiceMock) UpdateUser(ctx context.Context, ID string, name string, email string) error {
 	ret := _m.Called(ctx, ID, name, email)
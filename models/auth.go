@@ -1,6 +1,9 @@
 package models
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
@@ -12,5 +15,8 @@ type LoginPayload struct {
 }
 
 type LoginResponse struct {
-	Token string `json:"token"`
+	Token                 string    `json:"token"`
+	TokenExpiresAt        time.Time `json:"tokenExpiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
 }
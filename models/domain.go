@@ -0,0 +1,82 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+const (
+	DomainVerificationPrefix = "_link-fizz"
+	DomainVerificationValue  = "link-fizz-verification="
+)
+
+var (
+	ErrDomainNotFound           = errors.New("domain not found")
+	ErrDomainAlreadyExists      = errors.New("domain already exists")
+	ErrInvalidDomain            = errors.New("invalid domain")
+	ErrDomainNotBelongToUser    = errors.New("domain does not belong to user")
+	ErrDomainNotVerified        = errors.New("domain not verified")
+	ErrDomainVerificationFailed = errors.New("domain verification record not found")
+	ErrDomainInUse              = errors.New("domain still has links")
+)
+
+type Domain struct {
+	ID                string
+	UserID            string
+	Hostname          string
+	VerificationToken string
+	VerifiedAt        sql.NullTime
+	CreatedAt         time.Time
+}
+
+type DomainPayload struct {
+	Hostname string `json:"hostname"`
+}
+
+type DomainVerificationRecord struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type DomainResponse struct {
+	ID           string                   `json:"id"`
+	Hostname     string                   `json:"hostname"`
+	Verified     bool                     `json:"verified"`
+	VerifiedAt   string                   `json:"verifiedAt,omitempty"`
+	CreatedAt    string                   `json:"createdAt"`
+	Verification DomainVerificationRecord `json:"verification"`
+}
+
+func (d *Domain) IsVerified() bool {
+	return d.VerifiedAt.Valid
+}
+
+func (d *Domain) VerificationRecordName() string {
+	return DomainVerificationPrefix + "." + d.Hostname
+}
+
+func (d *Domain) VerificationRecordValue() string {
+	return DomainVerificationValue + d.VerificationToken
+}
+
+func (d *Domain) ToResponse() DomainResponse {
+	response := DomainResponse{
+		ID:        d.ID,
+		Hostname:  d.Hostname,
+		Verified:  d.IsVerified(),
+		CreatedAt: d.CreatedAt.Format(time.RFC3339),
+		Verification: DomainVerificationRecord{
+			Type:  "TXT",
+			Name:  d.VerificationRecordName(),
+			Value: d.VerificationRecordValue(),
+		},
+	}
+
+	if d.VerifiedAt.Valid {
+		response.VerifiedAt = d.VerifiedAt.Time.Format(time.RFC3339)
+	}
+
+	return response
+}
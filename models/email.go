@@ -0,0 +1,57 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrEmailNotVerified          = errors.New("email not verified")
+	ErrEmailAlreadyVerified      = errors.New("email already verified")
+	ErrInvalidVerificationToken  = errors.New("invalid verification token")
+	ErrVerificationResendTooSoon = errors.New("verification email sent too recently")
+	ErrUnknownMailerDriver       = errors.New("unknown mailer driver")
+)
+
+const (
+	MailerDriverSMTP   = "smtp"
+	MailerDriverOutbox = "outbox"
+	MailerDriverMemory = "memory"
+)
+
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type EmailVerificationClaims struct {
+	UserID string
+	Email  string
+}
+
+type VerifyEmailPayload struct {
+	Token string `json:"token"`
+}
+
+type Mailer struct {
+	Driver    string
+	From      string
+	OutboxDir string
+	SMTP      SMTP
+}
+
+type SMTP struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Timeout  time.Duration
+}
+
+type EmailVerification struct {
+	URL              string
+	TTL              time.Duration
+	ResendInterval   time.Duration
+	RequiredForLinks bool
+}
@@ -1,20 +1,93 @@
 package models
 
-import "time"
+import (
+	"net/netip"
+	"time"
+)
 
 type Environment struct {
 	APIPort        string
 	APIURL         string
+	InternalAddr   string
+	AdminToken     string
 	DBUser         string
 	DBPassword     string
 	DBHost         string
 	DBPort         string
 	DBName         string
 	RequestTimeout time.Duration
-	Key            Key
+	KeyRing        KeyRing
+	LinkNotActive  LinkNotActive
+	VisitQueue     VisitQueue
+	LinkCache      LinkCache
+	SessionCache   SessionCache
+	UnlockLimit    UnlockLimit
+	ReservedCodes  []string
+	ShortCode      ShortCode
+	GeoIP          GeoIP
+	Mailer         Mailer
+
+	EmailVerification EmailVerification
+
+	LinkBatchMaxItems       int
+	PermanentRedirectMaxAge time.Duration
+	CountryHeader           string
+	TrustedProxies          []netip.Prefix
+	AccessTokenTTL          time.Duration
+	RefreshTokenTTL         time.Duration
+	RefreshTokenReuseGrace  time.Duration
+	MaxSessionLifetime      time.Duration
+}
+
+// MaxShortCodeLength matches the width of the links.short_code column.
+const MaxShortCodeLength = 20
+
+type ShortCode struct {
+	Alphabet    string
+	Length      int
+	MaxLength   int
+	MaxAttempts int
+}
+
+type LinkCache struct {
+	Size        int
+	TTL         time.Duration
+	NegativeTTL time.Duration
+}
+
+type SessionCache struct {
+	Size int
+	TTL  time.Duration
+}
+
+type UnlockLimit struct {
+	Size            int
+	MaxAttempts     int
+	LinkMaxAttempts int
+	Window          time.Duration
+}
+
+type VisitQueue struct {
+	Size           int
+	Workers        int
+	BatchSize      int
+	FlushInterval  time.Duration
+	EnqueueTimeout time.Duration
+	DrainTimeout   time.Duration
+}
+
+type LinkNotActive struct {
+	StatusCode  int
+	RedirectURL string
+}
+
+type KeyRing struct {
+	PrimaryKeyID string
+	Keys         []Key
 }
 
 type Key struct {
+	ID         string
 	PrivateKey string
 	PublicKey  string
 }
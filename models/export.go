@@ -0,0 +1,91 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidExportFormat = errors.New("invalid export format")
+	ErrInvalidExportQuery  = errors.New("invalid export query")
+)
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV    ExportFormat = "csv"
+	ExportFormatJSON   ExportFormat = "json"
+	ExportFormatNDJSON ExportFormat = "ndjson"
+)
+
+type VisitExportQuery struct {
+	From *time.Time
+	To   *time.Time
+}
+
+type LinkVisitExport struct {
+	ID            string `json:"id"`
+	ShortCode     string `json:"shortCode"`
+	VisitedAt     string `json:"visitedAt"`
+	IP            string `json:"ip"`
+	UserAgent     string `json:"userAgent"`
+	Referrer      string `json:"referrer,omitempty"`
+	DestinationID string `json:"destinationId,omitempty"`
+	Country       string `json:"country,omitempty"`
+	Region        string `json:"region,omitempty"`
+	City          string `json:"city,omitempty"`
+}
+
+func ParseExportFormat(value string) (ExportFormat, error) {
+	switch format := ExportFormat(value); format {
+	case "":
+		return ExportFormatCSV, nil
+	case ExportFormatCSV, ExportFormatJSON, ExportFormatNDJSON:
+		return format, nil
+	default:
+		return "", ErrInvalidExportFormat
+	}
+}
+
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportFormatJSON:
+		return "application/json"
+	case ExportFormatNDJSON:
+		return "application/x-ndjson"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+func (v *LinkVisit) ToExport(shortCode string) LinkVisitExport {
+	export := LinkVisitExport{
+		ID:        v.ID,
+		ShortCode: shortCode,
+		VisitedAt: v.VisitedAt.UTC().Format(time.RFC3339),
+		IP:        v.IP,
+		UserAgent: v.Agent,
+	}
+
+	if v.Referrer.Valid {
+		export.Referrer = v.Referrer.String
+	}
+
+	if v.DestinationID.Valid {
+		export.DestinationID = v.DestinationID.String
+	}
+
+	if v.Country.Valid {
+		export.Country = v.Country.String
+	}
+
+	if v.Region.Valid {
+		export.Region = v.Region.String
+	}
+
+	if v.City.Valid {
+		export.City = v.City.String
+	}
+
+	return export
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+const MaxFolderNameLength = 50
+
+var (
+	ErrFolderNotFound        = errors.New("folder not found")
+	ErrFolderAlreadyExists   = errors.New("folder already exists")
+	ErrInvalidFolderName     = errors.New("invalid folder name")
+	ErrFolderNotBelongToUser = errors.New("folder does not belong to user")
+)
+
+type Folder struct {
+	ID        string
+	UserID    string
+	Name      string
+	LinkCount int
+	CreatedAt time.Time
+	UpdatedAt sql.NullTime
+}
+
+type FolderPayload struct {
+	Name string `json:"name"`
+}
+
+type FolderResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	LinkCount int    `json:"linkCount"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+func (f *Folder) ToResponse() FolderResponse {
+	response := FolderResponse{
+		ID:        f.ID,
+		Name:      f.Name,
+		LinkCount: f.LinkCount,
+		CreatedAt: f.CreatedAt.Format(time.RFC3339),
+	}
+
+	if f.UpdatedAt.Valid {
+		response.UpdatedAt = f.UpdatedAt.Time.Format(time.RFC3339)
+	}
+
+	return response
+}
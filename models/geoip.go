@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+type GeoIP struct {
+	DatabasePath   string
+	ReloadInterval time.Duration
+}
+
+type GeoLocation struct {
+	Country string
+	Region  string
+	City    string
+}
+
+type VisitLocation struct {
+	Country string `json:"country"`
+	Region  string `json:"region,omitempty"`
+	City    string `json:"city"`
+	Clicks  int    `json:"clicks"`
+}
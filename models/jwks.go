@@ -0,0 +1,21 @@
+package models
+
+import "errors"
+
+var (
+	ErrUnknownSigningKey = errors.New("unknown signing key")
+)
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"strings"
 	"time"
@@ -14,35 +15,172 @@ var (
 	ErrInvalidShortCode        = errors.New("invalid short code")
 	ErrCustomCodeAlreadyExists = errors.New("custom code already exists")
 	ErrLinkNotBelongToUser     = errors.New("link does not belong to user")
+	ErrLinkExpired             = errors.New("link expired")
+	ErrInvalidExpiration       = errors.New("expiration must be in the future")
+	ErrLinkClickLimitReached   = errors.New("link click limit reached")
+	ErrInvalidMaxClicks        = errors.New("max clicks must be greater than zero")
+	ErrLinkPasswordRequired    = errors.New("link password required")
+	ErrInvalidLinkPassword     = errors.New("invalid link password")
+	ErrTooManyUnlockAttempts   = errors.New("too many unlock attempts")
+	ErrLinkNotActive           = errors.New("link not active yet")
+	ErrInvalidActivationWindow = errors.New("activation must be before expiration")
+	ErrConflictingSchedule     = errors.New("schedule field cannot be set and cleared at once")
+	ErrConflictingMaxClicks    = errors.New("max clicks cannot be set and cleared at once")
+	ErrReservedShortCode       = errors.New("short code is reserved")
+	ErrShortCodeTaken          = errors.New("short code already taken")
+	ErrShortCodeExhausted      = errors.New("could not generate a unique short code")
+	ErrInvalidLinkURL          = errors.New("invalid URL")
 )
 
 type Link struct {
-	ID          string
-	Title       sql.NullString
-	OriginalURL string
-	ShortCode   string
-	UserID      string
-	CreatedAt   time.Time
-	UpdatedAt   sql.NullTime
+	ID                 string
+	Title              sql.NullString
+	OriginalURL        string
+	ShortCode          string
+	UserID             string
+	CreatedAt          time.Time
+	UpdatedAt          sql.NullTime
+	ActivatesAt        sql.NullTime
+	ExpiresAt          sql.NullTime
+	FallbackURL        sql.NullString
+	MaxClicks          sql.NullInt64
+	ClickCount         int64
+	PasswordHash       sql.NullString
+	Domain             sql.NullString
+	FolderID           sql.NullString
+	RedirectType       int
+	StickyDestinations bool
+	Tags               []Tag
+	Destinations       []LinkDestination
+	Rules              []LinkRule
 }
 
 type LinkPayload struct {
-	Title          *string `json:"title,omitempty"`
-	DestinationURL string  `json:"destinationUrl"`
-	CustomCode     *string `json:"customCode,omitempty"`
+	Title          *string    `json:"title,omitempty"`
+	DestinationURL string     `json:"destinationUrl"`
+	CustomCode     *string    `json:"customCode,omitempty"`
+	ActivatesAt    *time.Time `json:"activatesAt,omitempty"`
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
+	FallbackURL    *string    `json:"fallbackUrl,omitempty"`
+	MaxClicks      *int64     `json:"maxClicks,omitempty"`
+	Password       *string    `json:"password,omitempty"`
+	Domain         *string    `json:"domain,omitempty"`
+	FolderID       *string    `json:"folderId,omitempty"`
+	TagIDs         []string   `json:"tagIds,omitempty"`
+	RedirectType   *int       `json:"redirectType,omitempty"`
+
+	Destinations       []LinkDestinationPayload `json:"destinations,omitempty"`
+	StickyDestinations *bool                    `json:"stickyDestinations,omitempty"`
+}
+
+type UpdateLinkPayload struct {
+	Title          *string    `json:"title,omitempty"`
+	DestinationURL *string    `json:"destinationUrl,omitempty"`
+	ActivatesAt    *time.Time `json:"activatesAt,omitempty"`
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
+	FallbackURL    *string    `json:"fallbackUrl,omitempty"`
+	MaxClicks      *int64     `json:"maxClicks,omitempty"`
+	Password       *string    `json:"password,omitempty"`
+	FolderID       *string    `json:"folderId,omitempty"`
+	TagIDs         *[]string  `json:"tagIds,omitempty"`
+	RedirectType   *int       `json:"redirectType,omitempty"`
+
+	Destinations       *[]LinkDestinationPayload `json:"destinations,omitempty"`
+	StickyDestinations *bool                     `json:"stickyDestinations,omitempty"`
+
+	ClearActivatesAt bool `json:"clearActivatesAt,omitempty"`
+	ClearExpiresAt   bool `json:"clearExpiresAt,omitempty"`
+	ClearMaxClicks   bool `json:"clearMaxClicks,omitempty"`
+}
+
+type LinkAddress struct {
+	Domain    string
+	ShortCode string
 }
 
 type CreateLinkResponse struct {
-	ShortCode string `json:"shortCode"`
+	ShortCode    string   `json:"shortCode"`
+	RedirectType int      `json:"redirectType"`
+	Warnings     []string `json:"warnings,omitempty"`
 }
 
 type LinkResponse struct {
-	ID          string `json:"id"`
-	Title       string `json:"title"`
-	OriginalURL string `json:"originalUrl"`
-	ShortCode   string `json:"shortCode"`
-	ShortURL    string `json:"shortUrl"`
-	CreatedAt   string `json:"createdAt"`
+	ID                string            `json:"id"`
+	Title             string            `json:"title"`
+	OriginalURL       string            `json:"originalUrl"`
+	ShortCode         string            `json:"shortCode"`
+	ShortURL          string            `json:"shortUrl"`
+	CreatedAt         string            `json:"createdAt"`
+	UpdatedAt         string            `json:"updatedAt,omitempty"`
+	ActivatesAt       string            `json:"activatesAt,omitempty"`
+	ExpiresAt         string            `json:"expiresAt,omitempty"`
+	FallbackURL       string            `json:"fallbackUrl,omitempty"`
+	MaxClicks         *int64            `json:"maxClicks,omitempty"`
+	ClickCount        int64             `json:"clickCount"`
+	PasswordProtected bool              `json:"passwordProtected"`
+	Domain            string            `json:"domain,omitempty"`
+	FolderID          string            `json:"folderId,omitempty"`
+	Tags              []LinkTagResponse `json:"tags,omitempty"`
+	RedirectType      int               `json:"redirectType"`
+	Warnings          []string          `json:"warnings,omitempty"`
+
+	Destinations       []LinkDestinationResponse `json:"destinations,omitempty"`
+	StickyDestinations bool                      `json:"stickyDestinations,omitempty"`
+}
+
+func (l *Link) IsActive(now time.Time) bool {
+	return !l.ActivatesAt.Valid || !now.Before(l.ActivatesAt.Time)
+}
+
+func (l *Link) IsExpired(now time.Time) bool {
+	return l.ExpiresAt.Valid && !now.Before(l.ExpiresAt.Time)
+}
+
+func (l *Link) IsClickLimitReached() bool {
+	return l.MaxClicks.Valid && l.ClickCount >= l.MaxClicks.Int64
+}
+
+func (l *Link) IsPasswordProtected() bool {
+	return l.PasswordHash.Valid && l.PasswordHash.String != ""
+}
+
+// IsPubliclyCacheable reports whether a shared cache may replay the redirect:
+// only when every visit would get the same answer without the server checking
+// a password, counting a click or looking at the clock.
+func (l *Link) IsPubliclyCacheable() bool {
+	return len(l.Rules) == 0 &&
+		len(l.Destinations) == 0 &&
+		!l.IsPasswordProtected() &&
+		!l.MaxClicks.Valid &&
+		!l.ExpiresAt.Valid &&
+		!l.ActivatesAt.Valid
+}
+
+// DomainKey identifies the domain a link is served on; the default domain is the empty string.
+func (l *Link) DomainKey() string {
+	if l.Domain.Valid {
+		return l.Domain.String
+	}
+
+	return ""
+}
+
+// HostDomainKey maps a request host onto the domain key its links are stored under.
+func HostDomainKey(host, defaultHost string) string {
+	host = strings.ToLower(stripPort(host))
+	if defaultHost != "" && strings.EqualFold(host, stripPort(defaultHost)) {
+		return ""
+	}
+
+	return host
+}
+
+func (l *Link) BaseURL(apiURL string) string {
+	if l.Domain.Valid {
+		return "https://" + l.Domain.String
+	}
+
+	return apiURL
 }
 
 func (l *Link) ToResponse(apiURL string) LinkResponse {
@@ -61,12 +199,66 @@ func (l *Link) ToResponse(apiURL string) LinkResponse {
 		}
 	}
 
-	return LinkResponse{
-		ID:          l.ID,
-		Title:       title,
-		OriginalURL: l.OriginalURL,
-		ShortCode:   l.ShortCode,
-		ShortURL:    fmt.Sprintf("%s/%s", apiURL, l.ShortCode),
-		CreatedAt:   l.CreatedAt.Format(time.RFC3339),
+	response := LinkResponse{
+		ID:                l.ID,
+		Title:             title,
+		OriginalURL:       l.OriginalURL,
+		ShortCode:         l.ShortCode,
+		ShortURL:          fmt.Sprintf("%s/%s", l.BaseURL(apiURL), l.ShortCode),
+		CreatedAt:         l.CreatedAt.Format(time.RFC3339),
+		ClickCount:        l.ClickCount,
+		PasswordProtected: l.IsPasswordProtected(),
+		RedirectType:      l.RedirectType,
+		Warnings:          RedirectWarnings(l.RedirectType),
+	}
+
+	if l.UpdatedAt.Valid {
+		response.UpdatedAt = l.UpdatedAt.Time.Format(time.RFC3339)
+	}
+
+	if l.ActivatesAt.Valid {
+		response.ActivatesAt = l.ActivatesAt.Time.Format(time.RFC3339)
+	}
+
+	if l.ExpiresAt.Valid {
+		response.ExpiresAt = l.ExpiresAt.Time.Format(time.RFC3339)
+	}
+
+	if l.FallbackURL.Valid {
+		response.FallbackURL = l.FallbackURL.String
+	}
+
+	if l.MaxClicks.Valid {
+		response.MaxClicks = &l.MaxClicks.Int64
+	}
+
+	if l.Domain.Valid {
+		response.Domain = l.Domain.String
 	}
+
+	if l.FolderID.Valid {
+		response.FolderID = l.FolderID.String
+	}
+
+	for _, tag := range l.Tags {
+		response.Tags = append(response.Tags, LinkTagResponse{ID: tag.ID, Name: tag.Name})
+	}
+
+	for _, destination := range l.Destinations {
+		response.Destinations = append(response.Destinations, destination.ToResponse())
+	}
+
+	if len(l.Destinations) > 0 {
+		response.StickyDestinations = l.StickyDestinations
+	}
+
+	return response
+}
+
+func stripPort(host string) string {
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		return hostname
+	}
+
+	return host
 }
@@ -0,0 +1,27 @@
+package models
+
+import "errors"
+
+var (
+	ErrEmptyLinkBatch    = errors.New("link batch is empty")
+	ErrLinkBatchTooLarge = errors.New("link batch is too large")
+	ErrInvalidLinkCSV    = errors.New("invalid link CSV")
+)
+
+type BatchLinkPayload struct {
+	Links []LinkPayload `json:"links"`
+}
+
+type BatchLinkResult struct {
+	Index     int    `json:"index"`
+	Line      int    `json:"line,omitempty"`
+	ShortCode string `json:"shortCode,omitempty"`
+	ShortURL  string `json:"shortUrl,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type BatchLinkResponse struct {
+	Created int               `json:"created"`
+	Failed  int               `json:"failed"`
+	Results []BatchLinkResult `json:"results"`
+}
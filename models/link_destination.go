@@ -0,0 +1,63 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+)
+
+const (
+	MinLinkDestinations       = 2
+	MaxLinkDestinations       = 10
+	MaxDestinationWeight      = 1000
+	MaxDestinationLabelLength = 50
+)
+
+var (
+	ErrInvalidLinkDestinations  = errors.New("a link must have between 2 and 10 destinations")
+	ErrInvalidDestinationWeight = errors.New("destination weight must be between 1 and 1000")
+	ErrInvalidDestinationLabel  = errors.New("destination label must be at most 50 characters")
+)
+
+type LinkDestination struct {
+	ID       string
+	LinkID   string
+	URL      string
+	Label    sql.NullString
+	Weight   int
+	Position int
+}
+
+type LinkDestinationPayload struct {
+	URL    string  `json:"url"`
+	Weight int     `json:"weight"`
+	Label  *string `json:"label,omitempty"`
+}
+
+type LinkDestinationResponse struct {
+	ID     string `json:"id"`
+	URL    string `json:"url"`
+	Label  string `json:"label,omitempty"`
+	Weight int    `json:"weight"`
+}
+
+type DestinationClicks struct {
+	ID     string `json:"id"`
+	URL    string `json:"url,omitempty"`
+	Label  string `json:"label,omitempty"`
+	Weight int    `json:"weight,omitempty"`
+	Clicks int    `json:"clicks"`
+}
+
+func (d *LinkDestination) ToResponse() LinkDestinationResponse {
+	response := LinkDestinationResponse{
+		ID:     d.ID,
+		URL:    d.URL,
+		Weight: d.Weight,
+	}
+
+	if d.Label.Valid {
+		response.Label = d.Label.String
+	}
+
+	return response
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	DefaultLinkPageSize = 20
+	MaxLinkPageSize     = 100
+)
+
+var (
+	ErrInvalidLinkQuery  = errors.New("invalid link query")
+	ErrInvalidLinkCursor = errors.New("invalid link cursor")
+)
+
+type LinkSortField string
+
+const (
+	LinkSortCreated LinkSortField = "created"
+	LinkSortUpdated LinkSortField = "updated"
+	LinkSortClicks  LinkSortField = "clicks"
+)
+
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+type LinkQuery struct {
+	Limit  int
+	Cursor string
+	Sort   LinkSortField
+	Order  SortOrder
+	From   *time.Time
+	To     *time.Time
+	Domain string
+	Search string
+	Tag    string
+	Folder string
+	After  *LinkCursor
+}
+
+type LinkCursor struct {
+	Sort   LinkSortField `json:"s"`
+	Time   time.Time     `json:"t,omitempty"`
+	Clicks int64         `json:"c,omitempty"`
+	ID     string        `json:"id"`
+}
+
+type LinkPage struct {
+	Items      []LinkResponse `json:"items"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+	Total      int            `json:"total"`
+}
+
+func (l *Link) CursorFor(sort LinkSortField) LinkCursor {
+	cursor := LinkCursor{Sort: sort, ID: l.ID}
+
+	switch sort {
+	case LinkSortUpdated:
+		cursor.Time = l.CreatedAt
+		if l.UpdatedAt.Valid {
+			cursor.Time = l.UpdatedAt.Time
+		}
+	case LinkSortClicks:
+		cursor.Clicks = l.ClickCount
+	default:
+		cursor.Time = l.CreatedAt
+	}
+
+	return cursor
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+const (
+	MaxLinkRules           = 20
+	MaxRuleConditions      = 10
+	MaxRuleConditionValues = 20
+	MaxRuleValueLength     = 200
+)
+
+var (
+	ErrLinkRuleNotFound          = errors.New("link rule not found")
+	ErrLinkRuleLimitReached      = errors.New("a link can have at most 20 rules")
+	ErrInvalidRuleConditions     = errors.New("a rule must have between 1 and 10 conditions")
+	ErrInvalidRuleConditionType  = errors.New("rule condition type must be one of device, os, language, country, referrer, time or query")
+	ErrInvalidRuleConditionValue = errors.New("invalid rule condition value")
+	ErrInvalidRulePosition       = errors.New("invalid rule position")
+)
+
+type RuleConditionType string
+
+const (
+	RuleConditionDevice   RuleConditionType = "device"
+	RuleConditionOS       RuleConditionType = "os"
+	RuleConditionLanguage RuleConditionType = "language"
+	RuleConditionCountry  RuleConditionType = "country"
+	RuleConditionReferrer RuleConditionType = "referrer"
+	RuleConditionTime     RuleConditionType = "time"
+	RuleConditionQuery    RuleConditionType = "query"
+)
+
+const (
+	DeviceDesktop = "desktop"
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceBot     = "bot"
+)
+
+const (
+	OSWindows  = "windows"
+	OSMacOS    = "macos"
+	OSLinux    = "linux"
+	OSIOS      = "ios"
+	OSAndroid  = "android"
+	OSChromeOS = "chromeos"
+	OSOther    = "other"
+)
+
+type LinkRule struct {
+	ID             string
+	LinkID         string
+	Position       int
+	DestinationURL string
+	Conditions     []RuleCondition
+	CreatedAt      time.Time
+	UpdatedAt      sql.NullTime
+}
+
+type RuleCondition struct {
+	Type     RuleConditionType `json:"type"`
+	Key      string            `json:"key,omitempty"`
+	Values   []string          `json:"values"`
+	Timezone string            `json:"timezone,omitempty"`
+
+	// Resolved once from Timezone and Values when a time condition is
+	// validated or loaded, so redirects don't parse them on every visit.
+	Location   *time.Location `json:"-"`
+	TimeRanges []TimeRange    `json:"-"`
+}
+
+// TimeRange is a window of minutes since midnight; it wraps past midnight when
+// Start is after End.
+type TimeRange struct {
+	Start int
+	End   int
+}
+
+func (r TimeRange) Contains(minute int) bool {
+	if r.Start < r.End {
+		return minute >= r.Start && minute < r.End
+	}
+
+	return minute >= r.Start || minute < r.End
+}
+
+type LinkRulePayload struct {
+	DestinationURL string          `json:"destinationUrl"`
+	Conditions     []RuleCondition `json:"conditions"`
+	Position       *int            `json:"position,omitempty"`
+}
+
+type LinkRuleResponse struct {
+	ID             string          `json:"id"`
+	Position       int             `json:"position"`
+	DestinationURL string          `json:"destinationUrl"`
+	Conditions     []RuleCondition `json:"conditions"`
+	CreatedAt      string          `json:"createdAt"`
+	UpdatedAt      string          `json:"updatedAt,omitempty"`
+}
+
+func (r *LinkRule) ToResponse() LinkRuleResponse {
+	response := LinkRuleResponse{
+		ID:             r.ID,
+		Position:       r.Position,
+		DestinationURL: r.DestinationURL,
+		Conditions:     r.Conditions,
+		CreatedAt:      r.CreatedAt.Format(time.RFC3339),
+	}
+
+	if r.UpdatedAt.Valid {
+		response.UpdatedAt = r.UpdatedAt.Time.Format(time.RFC3339)
+	}
+
+	return response
+}
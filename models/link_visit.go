@@ -1,11 +1,86 @@
 package models
 
-import "time"
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+const (
+	DefaultStatsRange = 30 * 24 * time.Hour
+	TopStatsLimit     = 10
+)
+
+var (
+	ErrInvalidStatsQuery   = errors.New("invalid stats query")
+	ErrVisitRecorderClosed = errors.New("visit recorder closed")
+)
 
 type LinkVisit struct {
-	ID        string
-	LinkID    string
-	IP        string
-	Agent     string
-	VisitedAt time.Time
+	ID            string
+	LinkID        string
+	DestinationID sql.NullString
+	IP            string
+	Agent         string
+	Referrer      sql.NullString
+	Country       sql.NullString
+	Region        sql.NullString
+	City          sql.NullString
+	VisitedAt     time.Time
+}
+
+type StatsBucket string
+
+const (
+	StatsBucketHour StatsBucket = "hour"
+	StatsBucketDay  StatsBucket = "day"
+	StatsBucketWeek StatsBucket = "week"
+)
+
+type LinkStatsQuery struct {
+	From   time.Time
+	To     time.Time
+	Bucket StatsBucket
+}
+
+type VisitSummary struct {
+	TotalClicks    int
+	UniqueVisitors int
+}
+
+type VisitBucket struct {
+	Start  time.Time `json:"start"`
+	Clicks int       `json:"clicks"`
+}
+
+type VisitCount struct {
+	Value  string `json:"value"`
+	Clicks int    `json:"clicks"`
+}
+
+type LinkStatsResponse struct {
+	ShortCode      string          `json:"shortCode"`
+	From           time.Time       `json:"from"`
+	To             time.Time       `json:"to"`
+	Bucket         StatsBucket     `json:"bucket"`
+	TotalClicks    int             `json:"totalClicks"`
+	UniqueVisitors int             `json:"uniqueVisitors"`
+	Timeline       []VisitBucket   `json:"timeline"`
+	TopUserAgents  []VisitCount    `json:"topUserAgents"`
+	TopReferrers   []VisitCount    `json:"topReferrers"`
+	TopCountries   []VisitCount    `json:"topCountries"`
+	TopCities      []VisitLocation `json:"topCities"`
+
+	Destinations []DestinationClicks `json:"destinations,omitempty"`
+}
+
+type VisitRecorderStats struct {
+	QueueDepth  int   `json:"queueDepth"`
+	QueueSize   int   `json:"queueSize"`
+	Enqueued    int64 `json:"enqueued"`
+	Backpressed int64 `json:"backpressed"`
+	Dropped     int64 `json:"dropped"`
+	Written     int64 `json:"written"`
+	Failed      int64 `json:"failed"`
+	Batches     int64 `json:"batches"`
 }
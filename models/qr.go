@@ -0,0 +1,46 @@
+package models
+
+import "errors"
+
+const (
+	DefaultQRSize    = 256
+	MaxQRSize        = 2048
+	DefaultQRMargin  = 4
+	MaxQRMargin      = 32
+	DefaultQRColor   = "000000"
+	DefaultQRBgColor = "ffffff"
+)
+
+var (
+	ErrInvalidQROptions = errors.New("invalid QR code options")
+)
+
+type QRFormat string
+
+const (
+	QRFormatPNG QRFormat = "png"
+	QRFormatSVG QRFormat = "svg"
+)
+
+type QRLevel string
+
+const (
+	QRLevelLow     QRLevel = "L"
+	QRLevelMedium  QRLevel = "M"
+	QRLevelQuality QRLevel = "Q"
+	QRLevelHigh    QRLevel = "H"
+)
+
+type QROptions struct {
+	Format     QRFormat
+	Size       int
+	Margin     *int
+	Level      QRLevel
+	Foreground string
+	Background string
+}
+
+type QRCode struct {
+	ContentType string
+	Data        []byte
+}
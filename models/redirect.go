@@ -0,0 +1,72 @@
+package models
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const DefaultRedirectType = http.StatusFound
+
+const PermanentRedirectWarning = "browsers cache 301 and 308 redirects, so repeat clicks from the same browser may bypass the short link and not be tracked"
+
+var ErrInvalidRedirectType = errors.New("redirect type must be one of 301, 302, 307 or 308")
+
+type RedirectRequest struct {
+	Host        string
+	ShortCode   string
+	IPAddress   string
+	UserAgent   string
+	Referrer    string
+	AccessToken string
+
+	DestinationID  string
+	AcceptLanguage string
+	Country        string
+	Region         string
+	City           string
+	Query          url.Values
+}
+
+type UnlockLinkPayload struct {
+	Password string `json:"password"`
+}
+
+type RedirectResponse struct {
+	DestinationURL    string
+	StatusCode        int
+	DestinationID     string
+	StickyDestination bool
+	Cacheable         bool
+}
+
+type UnlockLinkResponse struct {
+	DestinationURL    string
+	StatusCode        int
+	AccessToken       string
+	ExpiresAt         time.Time
+	DestinationID     string
+	StickyDestination bool
+}
+
+func IsValidRedirectType(statusCode int) bool {
+	switch statusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	default:
+		return false
+	}
+}
+
+func IsPermanentRedirect(statusCode int) bool {
+	return statusCode == http.StatusMovedPermanently || statusCode == http.StatusPermanentRedirect
+}
+
+func RedirectWarnings(statusCode int) []string {
+	if IsPermanentRedirect(statusCode) {
+		return []string{PermanentRedirectWarning}
+	}
+
+	return nil
+}
@@ -1,13 +1,70 @@
 package models
 
-import "time"
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var (
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrSessionExpired      = errors.New("session expired")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reused")
+)
 
 type Session struct {
-	ID       string
-	UserID   string
-	Token    string
-	IP       string
-	Agent    string
-	CreateAt time.Time
-	ExpireAt time.Time
+	ID               string
+	UserID           string
+	Token            string
+	RefreshTokenHash string
+	IP               string
+	Agent            string
+	CreateAt         time.Time
+	ExpireAt         time.Time
+	RefreshedAt      sql.NullTime
+}
+
+type SessionState struct {
+	UserID       string
+	ExpireAt     time.Time
+	UserDisabled bool
+}
+
+type RefreshTokenPayload struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type SessionResponse struct {
+	ID           string    `json:"id"`
+	IP           string    `json:"ip"`
+	Agent        string    `json:"agent"`
+	Device       string    `json:"device"`
+	OS           string    `json:"os"`
+	CreatedAt    time.Time `json:"createdAt"`
+	LastActiveAt time.Time `json:"lastActiveAt"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+	Current      bool      `json:"current"`
+}
+
+type RevokeSessionsResponse struct {
+	Revoked int `json:"revoked"`
+}
+
+func (s *Session) ToResponse(currentSessionID string) SessionResponse {
+	response := SessionResponse{
+		ID:           s.ID,
+		IP:           s.IP,
+		Agent:        s.Agent,
+		CreatedAt:    s.CreateAt,
+		LastActiveAt: s.CreateAt,
+		ExpiresAt:    s.ExpireAt,
+		Current:      s.ID == currentSessionID,
+	}
+
+	if s.RefreshedAt.Valid {
+		response.LastActiveAt = s.RefreshedAt.Time
+	}
+
+	return response
 }
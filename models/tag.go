@@ -0,0 +1,57 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+const MaxTagNameLength = 50
+
+var (
+	ErrTagNotFound        = errors.New("tag not found")
+	ErrTagAlreadyExists   = errors.New("tag already exists")
+	ErrInvalidTagName     = errors.New("invalid tag name")
+	ErrTagNotBelongToUser = errors.New("tag does not belong to user")
+)
+
+type Tag struct {
+	ID        string
+	UserID    string
+	Name      string
+	LinkCount int
+	CreatedAt time.Time
+	UpdatedAt sql.NullTime
+}
+
+type TagPayload struct {
+	Name string `json:"name"`
+}
+
+type TagResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	LinkCount int    `json:"linkCount"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+type LinkTagResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (t *Tag) ToResponse() TagResponse {
+	response := TagResponse{
+		ID:        t.ID,
+		Name:      t.Name,
+		LinkCount: t.LinkCount,
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+	}
+
+	if t.UpdatedAt.Valid {
+		response.UpdatedAt = t.UpdatedAt.Time.Format(time.RFC3339)
+	}
+
+	return response
+}
@@ -9,15 +9,21 @@ import (
 var (
 	ErrUserAlreadyExists = fmt.Errorf("user already exists")
 	ErrUserNotFound      = fmt.Errorf("user not found")
+	ErrUserDisabled      = fmt.Errorf("user disabled")
 )
 
 type User struct {
-	ID           string
-	Name         string
-	Email        string
-	PasswordHash string
-	CreatedAt    time.Time
-	UpdatedAt    sql.NullString
+	ID                  string
+	Name                string
+	Email               string
+	PasswordHash        string
+	DefaultRedirectType int
+	CreatedAt           time.Time
+	UpdatedAt           sql.NullString
+	DisabledAt          sql.NullTime
+
+	EmailVerifiedAt         sql.NullTime
+	EmailVerificationSentAt sql.NullTime
 }
 
 type CreateUserPayload struct {
@@ -37,26 +43,37 @@ type UpdatePasswordPayload struct {
 	ConfirmPassword string `json:"confirmPassword"`
 }
 
+type UpdatePreferencesPayload struct {
+	DefaultRedirectType *int `json:"defaultRedirectType,omitempty"`
+}
+
 type UserResponse struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	Email               string   `json:"email"`
+	DefaultRedirectType int      `json:"defaultRedirectType"`
+	EmailVerified       bool     `json:"emailVerified"`
+	Warnings            []string `json:"warnings,omitempty"`
 }
 
 func NewUser(id, name, email, passwordHash string, createdAt time.Time) *User {
 	return &User{
-		ID:           id,
-		Name:         name,
-		Email:        email,
-		PasswordHash: passwordHash,
-		CreatedAt:    createdAt,
+		ID:                  id,
+		Name:                name,
+		Email:               email,
+		PasswordHash:        passwordHash,
+		DefaultRedirectType: DefaultRedirectType,
+		CreatedAt:           createdAt,
 	}
 }
 
 func (u *User) ToUseResponse() *UserResponse {
 	return &UserResponse{
-		ID:    u.ID,
-		Name:  u.Name,
-		Email: u.Email,
+		ID:                  u.ID,
+		Name:                u.Name,
+		Email:               u.Email,
+		DefaultRedirectType: u.DefaultRedirectType,
+		EmailVerified:       u.EmailVerifiedAt.Valid,
+		Warnings:            RedirectWarnings(u.DefaultRedirectType),
 	}
 }
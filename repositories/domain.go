@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+const domainColumns = "id, user_id, hostname, verification_token, verified_at, created_at"
+
+type DomainRepository interface {
+	CreateDomain(ctx context.Context, domain models.Domain) error
+	GetDomainByID(ctx context.Context, ID string) (*models.Domain, error)
+	GetVerifiedDomainByHostname(ctx context.Context, hostname string) (*models.Domain, error)
+	GetDomainsByUserID(ctx context.Context, userID string) ([]models.Domain, error)
+	MarkDomainVerified(ctx context.Context, ID string, verifiedAt time.Time) error
+	DeleteDomain(ctx context.Context, ID string) error
+}
+
+type domainRepository struct {
+	i  *di.Injector
+	db *sql.DB
+}
+
+func NewDomainRepository(i *di.Injector) (DomainRepository, error) {
+	db, err := di.Invoke[*sql.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke sql.DB: %w", err)
+	}
+
+	return &domainRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (d *domainRepository) CreateDomain(ctx context.Context, domain models.Domain) error {
+	statement, err := d.db.PrepareContext(ctx, "INSERT INTO domains ("+domainColumns+") VALUES (?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return fmt.Errorf("prepare insert: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, domain.ID, domain.UserID, domain.Hostname, domain.VerificationToken, domain.VerifiedAt, domain.CreatedAt)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return models.ErrDomainAlreadyExists
+		}
+		return fmt.Errorf("execute insert: %w", err)
+	}
+
+	return nil
+}
+
+func (d *domainRepository) GetDomainByID(ctx context.Context, ID string) (*models.Domain, error) {
+	statement, err := d.db.PrepareContext(ctx, "SELECT "+domainColumns+" FROM domains WHERE id = ?")
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	return scanDomain(statement.QueryRowContext(ctx, ID))
+}
+
+func (d *domainRepository) GetVerifiedDomainByHostname(ctx context.Context, hostname string) (*models.Domain, error) {
+	statement, err := d.db.PrepareContext(ctx, "SELECT "+domainColumns+" FROM domains WHERE verified_hostname = ?")
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	return scanDomain(statement.QueryRowContext(ctx, hostname))
+}
+
+func (d *domainRepository) GetDomainsByUserID(ctx context.Context, userID string) ([]models.Domain, error) {
+	statement, err := d.db.PrepareContext(ctx, "SELECT "+domainColumns+" FROM domains WHERE user_id = ? ORDER BY created_at")
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	domains := []models.Domain{}
+	for rows.Next() {
+		var domain models.Domain
+		if err := rows.Scan(domainFields(&domain)...); err != nil {
+			return nil, fmt.Errorf("scan domain: %w", err)
+		}
+		domains = append(domains, domain)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return domains, nil
+}
+
+func (d *domainRepository) MarkDomainVerified(ctx context.Context, ID string, verifiedAt time.Time) error {
+	statement, err := d.db.PrepareContext(ctx, "UPDATE domains SET verified_at = ? WHERE id = ?")
+	if err != nil {
+		return fmt.Errorf("prepare update: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, verifiedAt, ID)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return models.ErrDomainAlreadyExists
+		}
+		return fmt.Errorf("execute update: %w", err)
+	}
+
+	return nil
+}
+
+func (d *domainRepository) DeleteDomain(ctx context.Context, ID string) error {
+	statement, err := d.db.PrepareContext(ctx, "DELETE FROM domains WHERE id = ?")
+	if err != nil {
+		return fmt.Errorf("prepare delete: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, ID)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return models.ErrDomainInUse
+		}
+		return fmt.Errorf("execute delete: %w", err)
+	}
+
+	return nil
+}
+
+func scanDomain(row *sql.Row) (*models.Domain, error) {
+	var domain models.Domain
+	if err := row.Scan(domainFields(&domain)...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("scan domain: %w", err)
+	}
+
+	return &domain, nil
+}
+
+func domainFields(domain *models.Domain) []any {
+	return []any{
+		&domain.ID,
+		&domain.UserID,
+		&domain.Hostname,
+		&domain.VerificationToken,
+		&domain.VerifiedAt,
+		&domain.CreatedAt,
+	}
+}
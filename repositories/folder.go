@@ -0,0 +1,149 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+const folderColumns = "folders.id, folders.user_id, folders.name, folders.created_at, folders.updated_at"
+
+type FolderRepository interface {
+	CreateFolder(ctx context.Context, folder models.Folder) error
+	GetFolderByID(ctx context.Context, ID string) (*models.Folder, error)
+	GetFoldersByUserID(ctx context.Context, userID string) ([]models.Folder, error)
+	UpdateFolder(ctx context.Context, folder models.Folder) error
+	DeleteFolder(ctx context.Context, ID string) error
+}
+
+type folderRepository struct {
+	i  *di.Injector
+	db *sql.DB
+}
+
+func NewFolderRepository(i *di.Injector) (FolderRepository, error) {
+	db, err := di.Invoke[*sql.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke sql.DB: %w", err)
+	}
+
+	return &folderRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (f *folderRepository) CreateFolder(ctx context.Context, folder models.Folder) error {
+	statement, err := f.db.PrepareContext(ctx, "INSERT INTO folders (id, user_id, name, created_at, updated_at) VALUES (?, ?, ?, ?, ?)")
+	if err != nil {
+		return fmt.Errorf("prepare insert: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, folder.ID, folder.UserID, folder.Name, folder.CreatedAt, folder.UpdatedAt)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return models.ErrFolderAlreadyExists
+		}
+		return fmt.Errorf("execute insert: %w", err)
+	}
+
+	return nil
+}
+
+func (f *folderRepository) GetFolderByID(ctx context.Context, ID string) (*models.Folder, error) {
+	statement, err := f.db.PrepareContext(ctx,
+		"SELECT "+folderColumns+", COUNT(links.id) FROM folders LEFT JOIN links ON links.folder_id = folders.id WHERE folders.id = ? GROUP BY folders.id",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	var folder models.Folder
+	if err := statement.QueryRowContext(ctx, ID).Scan(append(folderFields(&folder), &folder.LinkCount)...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("scan folder: %w", err)
+	}
+
+	return &folder, nil
+}
+
+func (f *folderRepository) GetFoldersByUserID(ctx context.Context, userID string) ([]models.Folder, error) {
+	statement, err := f.db.PrepareContext(ctx,
+		"SELECT "+folderColumns+", COUNT(links.id) FROM folders LEFT JOIN links ON links.folder_id = folders.id WHERE folders.user_id = ? GROUP BY folders.id ORDER BY folders.name",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	folders := []models.Folder{}
+	for rows.Next() {
+		var folder models.Folder
+		if err := rows.Scan(append(folderFields(&folder), &folder.LinkCount)...); err != nil {
+			return nil, fmt.Errorf("scan folder: %w", err)
+		}
+		folders = append(folders, folder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return folders, nil
+}
+
+func (f *folderRepository) UpdateFolder(ctx context.Context, folder models.Folder) error {
+	statement, err := f.db.PrepareContext(ctx, "UPDATE folders SET name = ?, updated_at = ? WHERE id = ?")
+	if err != nil {
+		return fmt.Errorf("prepare update: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, folder.Name, folder.UpdatedAt, folder.ID)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return models.ErrFolderAlreadyExists
+		}
+		return fmt.Errorf("execute update: %w", err)
+	}
+
+	return nil
+}
+
+func (f *folderRepository) DeleteFolder(ctx context.Context, ID string) error {
+	statement, err := f.db.PrepareContext(ctx, "DELETE FROM folders WHERE id = ?")
+	if err != nil {
+		return fmt.Errorf("prepare delete: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("execute delete: %w", err)
+	}
+
+	return nil
+}
+
+func folderFields(folder *models.Folder) []any {
+	return []any{
+		&folder.ID,
+		&folder.UserID,
+		&folder.Name,
+		&folder.CreatedAt,
+		&folder.UpdatedAt,
+	}
+}
@@ -4,18 +4,46 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/g-villarinho/link-fizz-api/models"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
 )
 
+const linkColumns = "id, title, original_url, short_code, user_id, created_at, updated_at, activates_at, expires_at, fallback_url, max_clicks, click_count, password_hash, domain, folder_id, redirect_type, sticky_destinations"
+
+const linkInsertColumns = "id, title, original_url, user_id, short_code, created_at, activates_at, expires_at, fallback_url, max_clicks, password_hash, domain, folder_id, redirect_type, sticky_destinations"
+
+const linkInsertPlaceholders = "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+
+const linkInsertChunkSize = 500
+
+const linkDomainExpression = "SUBSTRING_INDEX(SUBSTRING_INDEX(SUBSTRING_INDEX(original_url, '://', -1), '/', 1), ':', 1)"
+
+var linkSortExpressions = map[models.LinkSortField]string{
+	models.LinkSortCreated: "created_at",
+	models.LinkSortUpdated: "COALESCE(updated_at, created_at)",
+	models.LinkSortClicks:  "click_count",
+}
+
+var likeEscaper = strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_")
+
 type LinkRepository interface {
 	CreateLink(ctx context.Context, link models.Link) error
-	GetOriginalURLByShortCode(ctx context.Context, shortCode string) (string, error)
+	CreateLinks(ctx context.Context, links []models.Link) error
+	GetExistingShortCodes(ctx context.Context, domain string, shortCodes []string) ([]string, error)
+	GetOriginalURLByShortCode(ctx context.Context, domain, shortCode string) (string, error)
 	GetLinkByID(ctx context.Context, ID string) (*models.Link, error)
-	GetAllShortCodesByUserID(ctx context.Context, userID string) ([]string, error)
-	GetLinkByShortCode(ctx context.Context, shortCode string) (*models.Link, error)
-	GetLinksByUserID(ctx context.Context, userID string) ([]models.Link, error)
+	GetLinkAddressesByUserID(ctx context.Context, userID string) ([]models.LinkAddress, error)
+	GetLinkByShortCode(ctx context.Context, domain, shortCode string) (*models.Link, error)
+	GetDestinationsByLinkIDs(ctx context.Context, linkIDs []string) (map[string][]models.LinkDestination, error)
+	GetLinksByUserID(ctx context.Context, userID string, query models.LinkQuery) ([]models.Link, error)
+	CountLinksByUserID(ctx context.Context, userID string, query models.LinkQuery) (int, error)
+	StreamLinksByUserID(ctx context.Context, userID string, query models.LinkQuery, fn func(link *models.Link) error) error
+	UpdateLink(ctx context.Context, link models.Link) error
+	DeleteLink(ctx context.Context, ID string) error
+	DeleteLinksByUserID(ctx context.Context, userID string) error
+	RegisterVisit(ctx context.Context, linkVisit *models.LinkVisit) error
 }
 
 type linkRepository struct {
@@ -36,23 +64,118 @@ func NewLinkRepository(i *di.Injector) (LinkRepository, error) {
 }
 
 func (l *linkRepository) CreateLink(ctx context.Context, link models.Link) error {
-	statement, err := l.db.PrepareContext(ctx, "INSERT INTO links (id, title, original_url, user_id, short_code, created_at) VALUES (?, ?, ?, ?, ?, ?)")
+	tx, err := l.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("prepare insert: %w", err)
+		return fmt.Errorf("begin transaction: %w", err)
 	}
-	defer statement.Close()
+	defer tx.Rollback()
 
-	_, err = statement.ExecContext(ctx, link.ID, link.Title, link.OriginalURL, link.UserID, link.ShortCode, link.CreatedAt)
+	_, err = tx.ExecContext(ctx, "INSERT INTO links ("+linkInsertColumns+") VALUES "+linkInsertPlaceholders, linkInsertValues(&link)...)
 	if err != nil {
+		if isDuplicateEntry(err) {
+			return models.ErrShortCodeTaken
+		}
 		return fmt.Errorf("execute insert: %w", err)
 	}
 
+	if err := insertLinkTags(ctx, tx, []models.Link{link}); err != nil {
+		return err
+	}
+
+	if err := insertLinkDestinations(ctx, tx, []models.Link{link}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
 	return nil
 }
 
-func (l *linkRepository) GetOriginalURLByShortCode(ctx context.Context, shortCode string) (string, error) {
+func (l *linkRepository) CreateLinks(ctx context.Context, links []models.Link) error {
+	if len(links) == 0 {
+		return nil
+	}
+
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for start := 0; start < len(links); start += linkInsertChunkSize {
+		chunk := links[start:min(start+linkInsertChunkSize, len(links))]
+
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]any, 0, len(chunk)*15)
+
+		for i := range chunk {
+			placeholders = append(placeholders, linkInsertPlaceholders)
+			args = append(args, linkInsertValues(&chunk[i])...)
+		}
+
+		_, err = tx.ExecContext(ctx, "INSERT INTO links ("+linkInsertColumns+") VALUES "+strings.Join(placeholders, ", "), args...)
+		if err != nil {
+			if isDuplicateEntry(err) {
+				return models.ErrShortCodeTaken
+			}
+			return fmt.Errorf("execute insert: %w", err)
+		}
+
+		if err := insertLinkTags(ctx, tx, chunk); err != nil {
+			return err
+		}
+
+		if err := insertLinkDestinations(ctx, tx, chunk); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (l *linkRepository) GetExistingShortCodes(ctx context.Context, domain string, shortCodes []string) ([]string, error) {
+	if len(shortCodes) == 0 {
+		return []string{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(shortCodes)), ", ")
+	args := make([]any, 0, len(shortCodes)+1)
+	args = append(args, domain)
+	for _, shortCode := range shortCodes {
+		args = append(args, shortCode)
+	}
+
+	rows, err := l.db.QueryContext(ctx, "SELECT short_code FROM links WHERE domain_key = ? AND short_code IN ("+placeholders+")", args...)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	existing := []string{}
+	for rows.Next() {
+		var shortCode string
+		if err := rows.Scan(&shortCode); err != nil {
+			return nil, fmt.Errorf("scan short code: %w", err)
+		}
+		existing = append(existing, shortCode)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return existing, nil
+}
+
+func (l *linkRepository) GetOriginalURLByShortCode(ctx context.Context, domain string, shortCode string) (string, error) {
 	var originalURL string
-	err := l.db.QueryRowContext(ctx, "SELECT original_url FROM links WHERE short_code = ?", shortCode).Scan(&originalURL)
+	err := l.db.QueryRowContext(ctx, "SELECT original_url FROM links WHERE domain_key = ? AND short_code = ?", domain, shortCode).Scan(&originalURL)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return "", nil
@@ -63,7 +186,7 @@ func (l *linkRepository) GetOriginalURLByShortCode(ctx context.Context, shortCod
 }
 
 func (l *linkRepository) GetLinkByID(ctx context.Context, ID string) (*models.Link, error) {
-	statement, err := l.db.PrepareContext(ctx, "SELECT id, title, original_url, short_code, user_id, created_at, updated_at FROM links WHERE id = ?")
+	statement, err := l.db.PrepareContext(ctx, "SELECT "+linkColumns+" FROM links WHERE id = ?")
 	if err != nil {
 		return nil, fmt.Errorf("prepare select: %w", err)
 	}
@@ -72,8 +195,8 @@ func (l *linkRepository) GetLinkByID(ctx context.Context, ID string) (*models.Li
 	return scanLink(statement.QueryRowContext(ctx, ID))
 }
 
-func (l *linkRepository) GetAllShortCodesByUserID(ctx context.Context, userID string) ([]string, error) {
-	statement, err := l.db.PrepareContext(ctx, "SELECT short_code FROM links WHERE user_id = ?")
+func (l *linkRepository) GetLinkAddressesByUserID(ctx context.Context, userID string) ([]models.LinkAddress, error) {
+	statement, err := l.db.PrepareContext(ctx, "SELECT domain_key, short_code FROM links WHERE user_id = ?")
 	if err != nil {
 		return nil, fmt.Errorf("prepare select: %w", err)
 	}
@@ -85,36 +208,119 @@ func (l *linkRepository) GetAllShortCodesByUserID(ctx context.Context, userID st
 	}
 	defer rows.Close()
 
-	var shortCodes []string
+	var addresses []models.LinkAddress
 	for rows.Next() {
-		var shortCode string
-		if err := rows.Scan(&shortCode); err != nil {
-			return nil, fmt.Errorf("scan short code: %w", err)
+		var address models.LinkAddress
+		if err := rows.Scan(&address.Domain, &address.ShortCode); err != nil {
+			return nil, fmt.Errorf("scan link address: %w", err)
 		}
-		shortCodes = append(shortCodes, shortCode)
+		addresses = append(addresses, address)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
 	}
 
-	return shortCodes, nil
+	return addresses, nil
 }
 
-func (l *linkRepository) GetLinkByShortCode(ctx context.Context, shortCode string) (*models.Link, error) {
-	statement, err := l.db.PrepareContext(ctx, "SELECT id, title, original_url, short_code, user_id, created_at, updated_at FROM links WHERE short_code = ?")
+func (l *linkRepository) GetLinkByShortCode(ctx context.Context, domain string, shortCode string) (*models.Link, error) {
+	statement, err := l.db.PrepareContext(ctx, "SELECT "+linkColumns+" FROM links WHERE domain_key = ? AND short_code = ?")
 	if err != nil {
 		return nil, fmt.Errorf("prepare select: %w", err)
 	}
 	defer statement.Close()
 
-	return scanLink(statement.QueryRowContext(ctx, shortCode))
+	link, err := scanLink(statement.QueryRowContext(ctx, domain, shortCode))
+	if err != nil || link == nil {
+		return link, err
+	}
+
+	destinations, err := l.GetDestinationsByLinkIDs(ctx, []string{link.ID})
+	if err != nil {
+		return nil, err
+	}
+	link.Destinations = destinations[link.ID]
+
+	rules, err := selectLinkRules(ctx, l.db, link.ID)
+	if err != nil {
+		return nil, err
+	}
+	link.Rules = rules
+
+	return link, nil
 }
 
-func (l *linkRepository) GetLinksByUserID(ctx context.Context, userID string) ([]models.Link, error) {
-	statement, err := l.db.PrepareContext(ctx, "SELECT id, title, original_url, short_code, user_id, created_at, updated_at FROM links WHERE user_id = ?")
+func (l *linkRepository) GetDestinationsByLinkIDs(ctx context.Context, linkIDs []string) (map[string][]models.LinkDestination, error) {
+	destinations := make(map[string][]models.LinkDestination)
+	if len(linkIDs) == 0 {
+		return destinations, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(linkIDs)), ", ")
+	args := make([]any, len(linkIDs))
+	for i, linkID := range linkIDs {
+		args[i] = linkID
+	}
+
+	statement, err := l.db.PrepareContext(ctx,
+		"SELECT id, link_id, url, label, weight, position FROM link_destinations WHERE link_id IN ("+placeholders+") ORDER BY link_id, position",
+	)
 	if err != nil {
 		return nil, fmt.Errorf("prepare select: %w", err)
 	}
 	defer statement.Close()
 
-	rows, err := statement.QueryContext(ctx, userID)
+	rows, err := statement.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var destination models.LinkDestination
+		if err := rows.Scan(&destination.ID, &destination.LinkID, &destination.URL, &destination.Label, &destination.Weight, &destination.Position); err != nil {
+			return nil, fmt.Errorf("scan link destination: %w", err)
+		}
+		destinations[destination.LinkID] = append(destinations[destination.LinkID], destination)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return destinations, nil
+}
+
+func (l *linkRepository) GetLinksByUserID(ctx context.Context, userID string, query models.LinkQuery) ([]models.Link, error) {
+	where, args := buildLinkFilter(userID, query)
+	sortExpr := linkSortExpressions[query.Sort]
+
+	direction, comparator := "DESC", "<"
+	if query.Order == models.SortAsc {
+		direction, comparator = "ASC", ">"
+	}
+
+	if query.After != nil {
+		var value any = query.After.Time
+		if query.Sort == models.LinkSortClicks {
+			value = query.After.Clicks
+		}
+
+		where += fmt.Sprintf(" AND (%s %s ? OR (%s = ? AND id %s ?))", sortExpr, comparator, sortExpr, comparator)
+		args = append(args, value, value, query.After.ID)
+	}
+
+	statement, err := l.db.PrepareContext(ctx, fmt.Sprintf(
+		"SELECT %s FROM links WHERE %s ORDER BY %s %s, id %s LIMIT ?",
+		linkColumns, where, sortExpr, direction, direction,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, append(args, query.Limit)...)
 	if err != nil {
 		return nil, fmt.Errorf("query select: %w", err)
 	}
@@ -123,18 +329,179 @@ func (l *linkRepository) GetLinksByUserID(ctx context.Context, userID string) ([
 	var links []models.Link
 	for rows.Next() {
 		var link models.Link
-		if err := rows.Scan(&link.ID, &link.Title, &link.OriginalURL, &link.ShortCode, &link.UserID, &link.CreatedAt, &link.UpdatedAt); err != nil {
+		if err := rows.Scan(linkFields(&link)...); err != nil {
 			return nil, fmt.Errorf("scan link: %w", err)
 		}
 		links = append(links, link)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
 	return links, nil
 }
 
+func (l *linkRepository) CountLinksByUserID(ctx context.Context, userID string, query models.LinkQuery) (int, error) {
+	where, args := buildLinkFilter(userID, query)
+
+	statement, err := l.db.PrepareContext(ctx, "SELECT COUNT(*) FROM links WHERE "+where)
+	if err != nil {
+		return 0, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	var total int
+	if err := statement.QueryRowContext(ctx, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("query count: %w", err)
+	}
+
+	return total, nil
+}
+
+func (l *linkRepository) StreamLinksByUserID(ctx context.Context, userID string, query models.LinkQuery, fn func(link *models.Link) error) error {
+	where, args := buildLinkFilter(userID, query)
+
+	statement, err := l.db.PrepareContext(ctx, "SELECT "+linkColumns+" FROM links WHERE "+where+" ORDER BY created_at, id")
+	if err != nil {
+		return fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var link models.Link
+		if err := rows.Scan(linkFields(&link)...); err != nil {
+			return fmt.Errorf("scan link: %w", err)
+		}
+
+		if err := fn(&link); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return nil
+}
+
+func (l *linkRepository) UpdateLink(ctx context.Context, link models.Link) error {
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE links SET title = ?, original_url = ?, updated_at = ?, activates_at = ?, expires_at = ?, fallback_url = ?, max_clicks = ?, password_hash = ?, folder_id = ?, redirect_type = ?, sticky_destinations = ? WHERE id = ?",
+		link.Title, link.OriginalURL, link.UpdatedAt, link.ActivatesAt, link.ExpiresAt, link.FallbackURL, link.MaxClicks, link.PasswordHash, link.FolderID, link.RedirectType, link.StickyDestinations, link.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("execute update: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM link_tags WHERE link_id = ?", link.ID); err != nil {
+		return fmt.Errorf("execute delete: %w", err)
+	}
+
+	if err := insertLinkTags(ctx, tx, []models.Link{link}); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM link_destinations WHERE link_id = ?", link.ID); err != nil {
+		return fmt.Errorf("execute delete: %w", err)
+	}
+
+	if err := insertLinkDestinations(ctx, tx, []models.Link{link}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (l *linkRepository) DeleteLink(ctx context.Context, ID string) error {
+	statement, err := l.db.PrepareContext(ctx, "DELETE FROM links WHERE id = ?")
+	if err != nil {
+		return fmt.Errorf("prepare delete: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("execute delete: %w", err)
+	}
+
+	return nil
+}
+
+func (l *linkRepository) DeleteLinksByUserID(ctx context.Context, userID string) error {
+	statement, err := l.db.PrepareContext(ctx, "DELETE FROM links WHERE user_id = ?")
+	if err != nil {
+		return fmt.Errorf("prepare delete: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("execute delete: %w", err)
+	}
+
+	return nil
+}
+
+func (l *linkRepository) RegisterVisit(ctx context.Context, linkVisit *models.LinkVisit) error {
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE links SET click_count = click_count + 1 WHERE id = ? AND (max_clicks IS NULL OR click_count < max_clicks)",
+		linkVisit.LinkID,
+	)
+	if err != nil {
+		return fmt.Errorf("execute update: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return models.ErrLinkClickLimitReached
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO link_visits (id, link_id, destination_id, ip, agent, referrer, country, region, city, visited_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		linkVisit.ID, linkVisit.LinkID, linkVisit.DestinationID, linkVisit.IP, linkVisit.Agent, linkVisit.Referrer, linkVisit.Country, linkVisit.Region, linkVisit.City, linkVisit.VisitedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("execute insert: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 func scanLink(row *sql.Row) (*models.Link, error) {
 	var link models.Link
-	err := row.Scan(&link.ID, &link.Title, &link.OriginalURL, &link.ShortCode, &link.UserID, &link.CreatedAt, &link.UpdatedAt)
+	err := row.Scan(linkFields(&link)...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -144,3 +511,129 @@ func scanLink(row *sql.Row) (*models.Link, error) {
 
 	return &link, nil
 }
+
+func linkFields(link *models.Link) []any {
+	return []any{
+		&link.ID,
+		&link.Title,
+		&link.OriginalURL,
+		&link.ShortCode,
+		&link.UserID,
+		&link.CreatedAt,
+		&link.UpdatedAt,
+		&link.ActivatesAt,
+		&link.ExpiresAt,
+		&link.FallbackURL,
+		&link.MaxClicks,
+		&link.ClickCount,
+		&link.PasswordHash,
+		&link.Domain,
+		&link.FolderID,
+		&link.RedirectType,
+		&link.StickyDestinations,
+	}
+}
+
+func linkInsertValues(link *models.Link) []any {
+	return []any{
+		link.ID,
+		link.Title,
+		link.OriginalURL,
+		link.UserID,
+		link.ShortCode,
+		link.CreatedAt,
+		link.ActivatesAt,
+		link.ExpiresAt,
+		link.FallbackURL,
+		link.MaxClicks,
+		link.PasswordHash,
+		link.Domain,
+		link.FolderID,
+		link.RedirectType,
+		link.StickyDestinations,
+	}
+}
+
+func insertLinkTags(ctx context.Context, tx *sql.Tx, links []models.Link) error {
+	placeholders := []string{}
+	args := []any{}
+
+	for _, link := range links {
+		for _, tag := range link.Tags {
+			placeholders = append(placeholders, "(?, ?)")
+			args = append(args, link.ID, tag.ID)
+		}
+	}
+
+	if len(placeholders) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, "INSERT INTO link_tags (link_id, tag_id) VALUES "+strings.Join(placeholders, ", "), args...)
+	if err != nil {
+		return fmt.Errorf("execute insert link tags: %w", err)
+	}
+
+	return nil
+}
+
+func insertLinkDestinations(ctx context.Context, tx *sql.Tx, links []models.Link) error {
+	placeholders := []string{}
+	args := []any{}
+
+	for _, link := range links {
+		for position, destination := range link.Destinations {
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?)")
+			args = append(args, destination.ID, link.ID, destination.URL, destination.Label, destination.Weight, position)
+		}
+	}
+
+	if len(placeholders) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, "INSERT INTO link_destinations (id, link_id, url, label, weight, position) VALUES "+strings.Join(placeholders, ", "), args...)
+	if err != nil {
+		return fmt.Errorf("execute insert link destinations: %w", err)
+	}
+
+	return nil
+}
+
+func buildLinkFilter(userID string, query models.LinkQuery) (string, []any) {
+	conditions := []string{"user_id = ?"}
+	args := []any{userID}
+
+	if query.From != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, *query.From)
+	}
+
+	if query.To != nil {
+		conditions = append(conditions, "created_at <= ?")
+		args = append(args, *query.To)
+	}
+
+	if query.Domain != "" {
+		conditions = append(conditions, linkDomainExpression+" IN (?, ?)")
+		args = append(args, query.Domain, "www."+query.Domain)
+	}
+
+	if query.Folder != "" {
+		conditions = append(conditions, "folder_id = ?")
+		args = append(args, query.Folder)
+	}
+
+	if query.Tag != "" {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM link_tags WHERE link_tags.link_id = links.id AND link_tags.tag_id = ?)")
+		args = append(args, query.Tag)
+	}
+
+	if query.Search != "" {
+		pattern := "%" + likeEscaper.Replace(query.Search) + "%"
+		conditions = append(conditions, "(title LIKE ? OR short_code LIKE ? OR original_url LIKE ?)")
+		args = append(args, pattern, pattern, pattern)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
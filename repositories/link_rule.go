@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type LinkRuleRepository interface {
+	GetRulesByLinkID(ctx context.Context, linkID string) ([]models.LinkRule, error)
+	ReplaceRules(ctx context.Context, linkID string, rules []models.LinkRule) error
+}
+
+type linkRuleRepository struct {
+	i  *di.Injector
+	db *sql.DB
+}
+
+func NewLinkRuleRepository(i *di.Injector) (LinkRuleRepository, error) {
+	db, err := di.Invoke[*sql.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke sql.DB: %w", err)
+	}
+
+	return &linkRuleRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (l *linkRuleRepository) GetRulesByLinkID(ctx context.Context, linkID string) ([]models.LinkRule, error) {
+	return selectLinkRules(ctx, l.db, linkID)
+}
+
+func (l *linkRuleRepository) ReplaceRules(ctx context.Context, linkID string, rules []models.LinkRule) error {
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM link_rules WHERE link_id = ?", linkID); err != nil {
+		return fmt.Errorf("execute delete: %w", err)
+	}
+
+	if len(rules) > 0 {
+		placeholders := make([]string, 0, len(rules))
+		args := make([]any, 0, len(rules)*7)
+
+		for position, rule := range rules {
+			conditions, err := jsoniter.Marshal(rule.Conditions)
+			if err != nil {
+				return fmt.Errorf("marshal conditions: %w", err)
+			}
+
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?)")
+			args = append(args, rule.ID, linkID, position, rule.DestinationURL, conditions, rule.CreatedAt, rule.UpdatedAt)
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO link_rules (id, link_id, position, destination_url, conditions, created_at, updated_at) VALUES "+strings.Join(placeholders, ", "),
+			args...,
+		)
+		if err != nil {
+			return fmt.Errorf("execute insert: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func selectLinkRules(ctx context.Context, db *sql.DB, linkID string) ([]models.LinkRule, error) {
+	statement, err := db.PrepareContext(ctx,
+		"SELECT id, link_id, position, destination_url, conditions, created_at, updated_at FROM link_rules WHERE link_id = ? ORDER BY position",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, linkID)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	rules := []models.LinkRule{}
+	for rows.Next() {
+		var rule models.LinkRule
+		var conditions []byte
+		if err := rows.Scan(&rule.ID, &rule.LinkID, &rule.Position, &rule.DestinationURL, &conditions, &rule.CreatedAt, &rule.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan link rule: %w", err)
+		}
+
+		if err := jsoniter.Unmarshal(conditions, &rule.Conditions); err != nil {
+			return nil, fmt.Errorf("unmarshal conditions: %w", err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return rules, nil
+}
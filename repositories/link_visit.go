@@ -4,13 +4,36 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/g-villarinho/link-fizz-api/models"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
 )
 
+// linkVisitWriteAttempts bounds how often a batch is retried after MySQL picks
+// it as a deadlock victim.
+const linkVisitWriteAttempts = 3
+
+var visitBucketExpressions = map[models.StatsBucket]string{
+	models.StatsBucketHour: "TIMESTAMP(DATE(visited_at), MAKETIME(HOUR(visited_at), 0, 0))",
+	models.StatsBucketDay:  "TIMESTAMP(DATE(visited_at))",
+	models.StatsBucketWeek: "TIMESTAMP(DATE_SUB(DATE(visited_at), INTERVAL WEEKDAY(visited_at) DAY))",
+}
+
 type LinkVisitRepository interface {
 	CreateLinkVisit(ctx context.Context, linkVisit *models.LinkVisit) error
+	CreateLinkVisits(ctx context.Context, linkVisits []*models.LinkVisit) error
+	GetVisitSummary(ctx context.Context, linkID string, from, to time.Time) (*models.VisitSummary, error)
+	GetVisitTimeline(ctx context.Context, linkID string, from, to time.Time, bucket models.StatsBucket) ([]models.VisitBucket, error)
+	GetTopUserAgents(ctx context.Context, linkID string, from, to time.Time, limit int) ([]models.VisitCount, error)
+	GetTopReferrers(ctx context.Context, linkID string, from, to time.Time, limit int) ([]models.VisitCount, error)
+	GetTopCountries(ctx context.Context, linkID string, from, to time.Time, limit int) ([]models.VisitCount, error)
+	GetTopCities(ctx context.Context, linkID string, from, to time.Time, limit int) ([]models.VisitLocation, error)
+	GetDestinationClicks(ctx context.Context, linkID string, from, to time.Time) (map[string]int, error)
+	StreamVisits(ctx context.Context, linkID string, query models.VisitExportQuery, fn func(linkVisit *models.LinkVisit) error) error
 }
 
 type linkVisitRepository struct {
@@ -31,16 +54,273 @@ func NewLinkVisitRepository(i *di.Injector) (LinkVisitRepository, error) {
 }
 
 func (l *linkVisitRepository) CreateLinkVisit(ctx context.Context, linkVisit *models.LinkVisit) error {
-	statement, err := l.db.PrepareContext(ctx, "INSERT INTO link_visits (id, link_id, ip, agent, visited_at) VALUES (?, ?, ?, ?, ?)")
+	statement, err := l.db.PrepareContext(ctx, "INSERT INTO link_visits (id, link_id, destination_id, ip, agent, referrer, country, region, city, visited_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
 	if err != nil {
 		return fmt.Errorf("prepare select: %w", err)
 	}
 	defer statement.Close()
 
-	_, err = statement.ExecContext(ctx, linkVisit.ID, linkVisit.LinkID, linkVisit.IP, linkVisit.Agent, linkVisit.VisitedAt)
+	_, err = statement.ExecContext(ctx, linkVisit.ID, linkVisit.LinkID, linkVisit.DestinationID, linkVisit.IP, linkVisit.Agent, linkVisit.Referrer, linkVisit.Country, linkVisit.Region, linkVisit.City, linkVisit.VisitedAt)
 	if err != nil {
 		return fmt.Errorf("exec select: %w", err)
 	}
 
 	return nil
 }
+
+func (l *linkVisitRepository) CreateLinkVisits(ctx context.Context, linkVisits []*models.LinkVisit) error {
+	if len(linkVisits) == 0 {
+		return nil
+	}
+
+	var err error
+	for range linkVisitWriteAttempts {
+		if err = l.createLinkVisits(ctx, linkVisits); !isDeadlock(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (l *linkVisitRepository) createLinkVisits(ctx context.Context, linkVisits []*models.LinkVisit) error {
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	placeholders := make([]string, 0, len(linkVisits))
+	args := make([]any, 0, len(linkVisits)*10)
+	clicksByLink := make(map[string]int)
+
+	for _, linkVisit := range linkVisits {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		args = append(args, linkVisit.ID, linkVisit.LinkID, linkVisit.DestinationID, linkVisit.IP, linkVisit.Agent, linkVisit.Referrer, linkVisit.Country, linkVisit.Region, linkVisit.City, linkVisit.VisitedAt)
+		clicksByLink[linkVisit.LinkID]++
+	}
+
+	// Take the exclusive row locks on links first and in a fixed order; the
+	// insert below only needs shared locks on the same rows for its foreign key,
+	// so concurrent batches queue up instead of deadlocking.
+	for _, linkID := range slices.Sorted(maps.Keys(clicksByLink)) {
+		if _, err := tx.ExecContext(ctx, "UPDATE links SET click_count = click_count + ? WHERE id = ?", clicksByLink[linkID], linkID); err != nil {
+			return fmt.Errorf("execute update: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO link_visits (id, link_id, destination_id, ip, agent, referrer, country, region, city, visited_at) VALUES "+strings.Join(placeholders, ", "),
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("execute insert: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (l *linkVisitRepository) GetVisitSummary(ctx context.Context, linkID string, from time.Time, to time.Time) (*models.VisitSummary, error) {
+	statement, err := l.db.PrepareContext(ctx, "SELECT COUNT(*), COUNT(DISTINCT ip) FROM link_visits WHERE link_id = ? AND visited_at >= ? AND visited_at < ?")
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	var summary models.VisitSummary
+	if err := statement.QueryRowContext(ctx, linkID, from, to).Scan(&summary.TotalClicks, &summary.UniqueVisitors); err != nil {
+		return nil, fmt.Errorf("query summary: %w", err)
+	}
+
+	return &summary, nil
+}
+
+func (l *linkVisitRepository) GetVisitTimeline(ctx context.Context, linkID string, from time.Time, to time.Time, bucket models.StatsBucket) ([]models.VisitBucket, error) {
+	bucketExpr, ok := visitBucketExpressions[bucket]
+	if !ok {
+		return nil, fmt.Errorf("unknown bucket %q", bucket)
+	}
+
+	statement, err := l.db.PrepareContext(ctx, fmt.Sprintf(
+		"SELECT %s AS bucket, COUNT(*) FROM link_visits WHERE link_id = ? AND visited_at >= ? AND visited_at < ? GROUP BY bucket ORDER BY bucket",
+		bucketExpr,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, linkID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	buckets := []models.VisitBucket{}
+	for rows.Next() {
+		var visitBucket models.VisitBucket
+		if err := rows.Scan(&visitBucket.Start, &visitBucket.Clicks); err != nil {
+			return nil, fmt.Errorf("scan bucket: %w", err)
+		}
+		buckets = append(buckets, visitBucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return buckets, nil
+}
+
+func (l *linkVisitRepository) GetTopUserAgents(ctx context.Context, linkID string, from time.Time, to time.Time, limit int) ([]models.VisitCount, error) {
+	return l.getTopValues(ctx, "agent", linkID, from, to, limit)
+}
+
+func (l *linkVisitRepository) GetTopReferrers(ctx context.Context, linkID string, from time.Time, to time.Time, limit int) ([]models.VisitCount, error) {
+	return l.getTopValues(ctx, "referrer", linkID, from, to, limit)
+}
+
+func (l *linkVisitRepository) GetTopCountries(ctx context.Context, linkID string, from time.Time, to time.Time, limit int) ([]models.VisitCount, error) {
+	return l.getTopValues(ctx, "country", linkID, from, to, limit)
+}
+
+func (l *linkVisitRepository) GetTopCities(ctx context.Context, linkID string, from time.Time, to time.Time, limit int) ([]models.VisitLocation, error) {
+	statement, err := l.db.PrepareContext(ctx, "SELECT country, COALESCE(region, ''), city, COUNT(*) AS clicks FROM link_visits WHERE link_id = ? AND visited_at >= ? AND visited_at < ? AND country IS NOT NULL AND city IS NOT NULL GROUP BY country, region, city ORDER BY clicks DESC LIMIT ?")
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, linkID, from, to, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	locations := []models.VisitLocation{}
+	for rows.Next() {
+		var location models.VisitLocation
+		if err := rows.Scan(&location.Country, &location.Region, &location.City, &location.Clicks); err != nil {
+			return nil, fmt.Errorf("scan city: %w", err)
+		}
+		locations = append(locations, location)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return locations, nil
+}
+
+func (l *linkVisitRepository) GetDestinationClicks(ctx context.Context, linkID string, from time.Time, to time.Time) (map[string]int, error) {
+	statement, err := l.db.PrepareContext(ctx, "SELECT destination_id, COUNT(*) FROM link_visits WHERE link_id = ? AND visited_at >= ? AND visited_at < ? AND destination_id IS NOT NULL GROUP BY destination_id")
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, linkID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	clicks := make(map[string]int)
+	for rows.Next() {
+		var destinationID string
+		var count int
+		if err := rows.Scan(&destinationID, &count); err != nil {
+			return nil, fmt.Errorf("scan destination clicks: %w", err)
+		}
+		clicks[destinationID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return clicks, nil
+}
+
+func (l *linkVisitRepository) StreamVisits(ctx context.Context, linkID string, query models.VisitExportQuery, fn func(linkVisit *models.LinkVisit) error) error {
+	conditions := []string{"link_id = ?"}
+	args := []any{linkID}
+
+	if query.From != nil {
+		conditions = append(conditions, "visited_at >= ?")
+		args = append(args, *query.From)
+	}
+
+	if query.To != nil {
+		conditions = append(conditions, "visited_at < ?")
+		args = append(args, *query.To)
+	}
+
+	statement, err := l.db.PrepareContext(ctx,
+		"SELECT id, link_id, destination_id, ip, agent, referrer, country, region, city, visited_at FROM link_visits WHERE "+strings.Join(conditions, " AND ")+" ORDER BY visited_at, id",
+	)
+	if err != nil {
+		return fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var linkVisit models.LinkVisit
+		if err := rows.Scan(&linkVisit.ID, &linkVisit.LinkID, &linkVisit.DestinationID, &linkVisit.IP, &linkVisit.Agent, &linkVisit.Referrer, &linkVisit.Country, &linkVisit.Region, &linkVisit.City, &linkVisit.VisitedAt); err != nil {
+			return fmt.Errorf("scan visit: %w", err)
+		}
+
+		if err := fn(&linkVisit); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return nil
+}
+
+func (l *linkVisitRepository) getTopValues(ctx context.Context, column, linkID string, from, to time.Time, limit int) ([]models.VisitCount, error) {
+	statement, err := l.db.PrepareContext(ctx, fmt.Sprintf(
+		"SELECT %s, COUNT(*) AS clicks FROM link_visits WHERE link_id = ? AND visited_at >= ? AND visited_at < ? AND %s IS NOT NULL AND %s <> '' GROUP BY %s ORDER BY clicks DESC LIMIT ?",
+		column, column, column, column,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, linkID, from, to, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	counts := []models.VisitCount{}
+	for rows.Next() {
+		var count models.VisitCount
+		if err := rows.Scan(&count.Value, &count.Clicks); err != nil {
+			return nil, fmt.Errorf("scan %s: %w", column, err)
+		}
+		counts = append(counts, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return counts, nil
+}
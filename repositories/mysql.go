@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const (
+	mysqlDuplicateEntry  = 1062
+	mysqlDeadlock        = 1213
+	mysqlRowIsReferenced = 1451
+)
+
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry
+}
+
+func isDeadlock(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDeadlock
+}
+
+func isForeignKeyViolation(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlRowIsReferenced
+}
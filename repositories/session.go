@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/g-villarinho/link-fizz-api/models"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
@@ -11,6 +12,11 @@ import (
 
 type SessionRepository interface {
 	CreateSession(ctx context.Context, session *models.Session) error
+	GetSessionByID(ctx context.Context, sessionID string) (*models.Session, error)
+	GetSessionState(ctx context.Context, sessionID string) (*models.SessionState, error)
+	GetSessionsByUserID(ctx context.Context, userID string, activeAt time.Time) ([]models.Session, error)
+	RotateSession(ctx context.Context, session *models.Session, previousRefreshTokenHash string, retiredBefore time.Time) (bool, error)
+	GetRefreshTokenRetiredAt(ctx context.Context, sessionID string, refreshTokenHash string) (sql.NullTime, error)
 	DeleteSession(ctx context.Context, sessionID string) error
 }
 
@@ -32,13 +38,13 @@ func NewSessionRepository(i *di.Injector) (SessionRepository, error) {
 }
 
 func (s *sessionRepository) CreateSession(ctx context.Context, session *models.Session) error {
-	statment, err := s.db.PrepareContext(ctx, "INSERT INTO sessions (id, user_id, token, ip, agent, created_at, expire_at) VALUES (?, ?, ?, ?, ?, ?, ?)")
+	statment, err := s.db.PrepareContext(ctx, "INSERT INTO sessions (id, user_id, token, refresh_token_hash, ip, agent, created_at, expire_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)")
 	if err != nil {
 		return fmt.Errorf("prepare statement: %w", err)
 	}
 	defer statment.Close()
 
-	_, err = statment.ExecContext(ctx, session.ID, session.UserID, session.Token, session.IP, session.Agent, session.CreateAt, session.ExpireAt)
+	_, err = statment.ExecContext(ctx, session.ID, session.UserID, session.Token, session.RefreshTokenHash, session.IP, session.Agent, session.CreateAt, session.ExpireAt)
 	if err != nil {
 		return fmt.Errorf("exec statement: %w", err)
 	}
@@ -46,6 +52,167 @@ func (s *sessionRepository) CreateSession(ctx context.Context, session *models.S
 	return nil
 }
 
+func (s *sessionRepository) GetSessionByID(ctx context.Context, sessionID string) (*models.Session, error) {
+	statment, err := s.db.PrepareContext(ctx, "SELECT id, user_id, token, refresh_token_hash, ip, agent, created_at, expire_at, refreshed_at FROM sessions WHERE id = ?")
+	if err != nil {
+		return nil, fmt.Errorf("prepare statement: %w", err)
+	}
+	defer statment.Close()
+
+	var session models.Session
+	err = statment.QueryRowContext(ctx, sessionID).Scan(
+		&session.ID,
+		&session.UserID,
+		&session.Token,
+		&session.RefreshTokenHash,
+		&session.IP,
+		&session.Agent,
+		&session.CreateAt,
+		&session.ExpireAt,
+		&session.RefreshedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("query row: %w", err)
+	}
+
+	return &session, nil
+}
+
+func (s *sessionRepository) GetSessionState(ctx context.Context, sessionID string) (*models.SessionState, error) {
+	statment, err := s.db.PrepareContext(ctx, "SELECT s.user_id, s.expire_at, u.disabled_at IS NOT NULL FROM sessions s INNER JOIN users u ON u.id = s.user_id WHERE s.id = ?")
+	if err != nil {
+		return nil, fmt.Errorf("prepare statement: %w", err)
+	}
+	defer statment.Close()
+
+	var state models.SessionState
+	if err := statment.QueryRowContext(ctx, sessionID).Scan(&state.UserID, &state.ExpireAt, &state.UserDisabled); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("query row: %w", err)
+	}
+
+	return &state, nil
+}
+
+func (s *sessionRepository) GetSessionsByUserID(ctx context.Context, userID string, activeAt time.Time) ([]models.Session, error) {
+	statment, err := s.db.PrepareContext(ctx, "SELECT id, user_id, token, refresh_token_hash, ip, agent, created_at, expire_at, refreshed_at FROM sessions WHERE user_id = ? AND expire_at > ? ORDER BY COALESCE(refreshed_at, created_at) DESC")
+	if err != nil {
+		return nil, fmt.Errorf("prepare statement: %w", err)
+	}
+	defer statment.Close()
+
+	rows, err := statment.QueryContext(ctx, userID, activeAt)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []models.Session{}
+	for rows.Next() {
+		var session models.Session
+		if err := rows.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.Token,
+			&session.RefreshTokenHash,
+			&session.IP,
+			&session.Agent,
+			&session.CreateAt,
+			&session.ExpireAt,
+			&session.RefreshedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func (s *sessionRepository) RotateSession(ctx context.Context, session *models.Session, previousRefreshTokenHash string, retiredBefore time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE sessions SET token = ?, refresh_token_hash = ?, ip = ?, agent = ?, expire_at = ?, refreshed_at = ? WHERE id = ? AND refresh_token_hash = ?",
+		session.Token,
+		session.RefreshTokenHash,
+		session.IP,
+		session.Agent,
+		session.ExpireAt,
+		session.RefreshedAt,
+		session.ID,
+		previousRefreshTokenHash,
+	)
+	if err != nil {
+		return false, fmt.Errorf("exec update: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO session_refresh_tokens (session_id, token_hash, retired_at) VALUES (?, ?, ?)",
+		session.ID,
+		previousRefreshTokenHash,
+		session.RefreshedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("exec insert: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"DELETE FROM session_refresh_tokens WHERE session_id = ? AND retired_at < ?",
+		session.ID,
+		retiredBefore,
+	)
+	if err != nil {
+		return false, fmt.Errorf("exec delete: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *sessionRepository) GetRefreshTokenRetiredAt(ctx context.Context, sessionID string, refreshTokenHash string) (sql.NullTime, error) {
+	statment, err := s.db.PrepareContext(ctx, "SELECT retired_at FROM session_refresh_tokens WHERE session_id = ? AND token_hash = ? LIMIT 1")
+	if err != nil {
+		return sql.NullTime{}, fmt.Errorf("prepare statement: %w", err)
+	}
+	defer statment.Close()
+
+	var retiredAt sql.NullTime
+	if err := statment.QueryRowContext(ctx, sessionID, refreshTokenHash).Scan(&retiredAt); err != nil {
+		if err == sql.ErrNoRows {
+			return sql.NullTime{}, nil
+		}
+		return sql.NullTime{}, fmt.Errorf("query row: %w", err)
+	}
+
+	return retiredAt, nil
+}
+
 func (s *sessionRepository) DeleteSession(ctx context.Context, sessionID string) error {
 	statment, err := s.db.PrepareContext(ctx, "DELETE FROM sessions WHERE id = ?")
 	if err != nil {
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+type ShortCodeRepository interface {
+	GetLength(ctx context.Context) (int, error)
+	RaiseLength(ctx context.Context, length int) (int, error)
+}
+
+type shortCodeRepository struct {
+	i  *di.Injector
+	db *sql.DB
+}
+
+func NewShortCodeRepository(i *di.Injector) (ShortCodeRepository, error) {
+	db, err := di.Invoke[*sql.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke sql.DB: %w", err)
+	}
+
+	return &shortCodeRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+// GetLength returns the persisted generation length, or 0 when it has never grown.
+func (s *shortCodeRepository) GetLength(ctx context.Context) (int, error) {
+	statement, err := s.db.PrepareContext(ctx, "SELECT length FROM short_code_state WHERE id = 1")
+	if err != nil {
+		return 0, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	var length int
+	err = statement.QueryRowContext(ctx).Scan(&length)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("query row: %w", err)
+	}
+
+	return length, nil
+}
+
+// RaiseLength stores length unless a longer one is already persisted and returns the stored value.
+func (s *shortCodeRepository) RaiseLength(ctx context.Context, length int) (int, error) {
+	statement, err := s.db.PrepareContext(ctx, "INSERT INTO short_code_state (id, length) VALUES (1, ?) ON DUPLICATE KEY UPDATE length = GREATEST(length, VALUES(length))")
+	if err != nil {
+		return 0, fmt.Errorf("prepare upsert: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, length)
+	if err != nil {
+		return 0, fmt.Errorf("exec upsert: %w", err)
+	}
+
+	return s.GetLength(ctx)
+}
@@ -0,0 +1,233 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+const tagColumns = "tags.id, tags.user_id, tags.name, tags.created_at, tags.updated_at"
+
+type TagRepository interface {
+	CreateTag(ctx context.Context, tag models.Tag) error
+	GetTagByID(ctx context.Context, ID string) (*models.Tag, error)
+	GetTagsByIDs(ctx context.Context, IDs []string) ([]models.Tag, error)
+	GetTagsByUserID(ctx context.Context, userID string) ([]models.Tag, error)
+	GetTagsByLinkIDs(ctx context.Context, linkIDs []string) (map[string][]models.Tag, error)
+	UpdateTag(ctx context.Context, tag models.Tag) error
+	DeleteTag(ctx context.Context, ID string) error
+}
+
+type tagRepository struct {
+	i  *di.Injector
+	db *sql.DB
+}
+
+func NewTagRepository(i *di.Injector) (TagRepository, error) {
+	db, err := di.Invoke[*sql.DB](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke sql.DB: %w", err)
+	}
+
+	return &tagRepository{
+		i:  i,
+		db: db,
+	}, nil
+}
+
+func (t *tagRepository) CreateTag(ctx context.Context, tag models.Tag) error {
+	statement, err := t.db.PrepareContext(ctx, "INSERT INTO tags (id, user_id, name, created_at, updated_at) VALUES (?, ?, ?, ?, ?)")
+	if err != nil {
+		return fmt.Errorf("prepare insert: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, tag.ID, tag.UserID, tag.Name, tag.CreatedAt, tag.UpdatedAt)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return models.ErrTagAlreadyExists
+		}
+		return fmt.Errorf("execute insert: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tagRepository) GetTagByID(ctx context.Context, ID string) (*models.Tag, error) {
+	statement, err := t.db.PrepareContext(ctx,
+		"SELECT "+tagColumns+", COUNT(link_tags.link_id) FROM tags LEFT JOIN link_tags ON link_tags.tag_id = tags.id WHERE tags.id = ? GROUP BY tags.id",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	var tag models.Tag
+	if err := statement.QueryRowContext(ctx, ID).Scan(append(tagFields(&tag), &tag.LinkCount)...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("scan tag: %w", err)
+	}
+
+	return &tag, nil
+}
+
+func (t *tagRepository) GetTagsByIDs(ctx context.Context, IDs []string) ([]models.Tag, error) {
+	if len(IDs) == 0 {
+		return []models.Tag{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(IDs)), ", ")
+	args := make([]any, len(IDs))
+	for i, ID := range IDs {
+		args[i] = ID
+	}
+
+	statement, err := t.db.PrepareContext(ctx, "SELECT "+tagColumns+" FROM tags WHERE id IN ("+placeholders+") ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []models.Tag{}
+	for rows.Next() {
+		var tag models.Tag
+		if err := rows.Scan(tagFields(&tag)...); err != nil {
+			return nil, fmt.Errorf("scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (t *tagRepository) GetTagsByUserID(ctx context.Context, userID string) ([]models.Tag, error) {
+	statement, err := t.db.PrepareContext(ctx,
+		"SELECT "+tagColumns+", COUNT(link_tags.link_id) FROM tags LEFT JOIN link_tags ON link_tags.tag_id = tags.id WHERE tags.user_id = ? GROUP BY tags.id ORDER BY tags.name",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []models.Tag{}
+	for rows.Next() {
+		var tag models.Tag
+		if err := rows.Scan(append(tagFields(&tag), &tag.LinkCount)...); err != nil {
+			return nil, fmt.Errorf("scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (t *tagRepository) GetTagsByLinkIDs(ctx context.Context, linkIDs []string) (map[string][]models.Tag, error) {
+	tagsByLink := make(map[string][]models.Tag, len(linkIDs))
+	if len(linkIDs) == 0 {
+		return tagsByLink, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(linkIDs)), ", ")
+	args := make([]any, len(linkIDs))
+	for i, linkID := range linkIDs {
+		args[i] = linkID
+	}
+
+	statement, err := t.db.PrepareContext(ctx,
+		"SELECT link_tags.link_id, "+tagColumns+" FROM link_tags JOIN tags ON tags.id = link_tags.tag_id WHERE link_tags.link_id IN ("+placeholders+") ORDER BY tags.name",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("prepare select: %w", err)
+	}
+	defer statement.Close()
+
+	rows, err := statement.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query select: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var linkID string
+		var tag models.Tag
+		if err := rows.Scan(append([]any{&linkID}, tagFields(&tag)...)...); err != nil {
+			return nil, fmt.Errorf("scan tag: %w", err)
+		}
+		tagsByLink[linkID] = append(tagsByLink[linkID], tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return tagsByLink, nil
+}
+
+func (t *tagRepository) UpdateTag(ctx context.Context, tag models.Tag) error {
+	statement, err := t.db.PrepareContext(ctx, "UPDATE tags SET name = ?, updated_at = ? WHERE id = ?")
+	if err != nil {
+		return fmt.Errorf("prepare update: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, tag.Name, tag.UpdatedAt, tag.ID)
+	if err != nil {
+		if isDuplicateEntry(err) {
+			return models.ErrTagAlreadyExists
+		}
+		return fmt.Errorf("execute update: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tagRepository) DeleteTag(ctx context.Context, ID string) error {
+	statement, err := t.db.PrepareContext(ctx, "DELETE FROM tags WHERE id = ?")
+	if err != nil {
+		return fmt.Errorf("prepare delete: %w", err)
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("execute delete: %w", err)
+	}
+
+	return nil
+}
+
+func tagFields(tag *models.Tag) []any {
+	return []any{
+		&tag.ID,
+		&tag.UserID,
+		&tag.Name,
+		&tag.CreatedAt,
+		&tag.UpdatedAt,
+	}
+}
@@ -14,6 +14,7 @@ type UserRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 	UpdateUser(ctx context.Context, user *models.User) error
 	DeleteUser(ctx context.Context, id string) error
+	UpdateDisabledAt(ctx context.Context, id string, disabledAt sql.NullTime) error
 }
 
 type userRepository struct {
@@ -34,13 +35,13 @@ func NewUserRepository(i *di.Injector) (UserRepository, error) {
 }
 
 func (u *userRepository) CreateUser(ctx context.Context, user *models.User) error {
-	statement, err := u.db.PrepareContext(ctx, "INSERT INTO users (id, name, email, password_hash, created_at) VALUES (?, ?, ?, ?, ?)")
+	statement, err := u.db.PrepareContext(ctx, "INSERT INTO users (id, name, email, password_hash, default_redirect_type, created_at) VALUES (?, ?, ?, ?, ?, ?)")
 	if err != nil {
 		return err
 	}
 	defer statement.Close()
 
-	_, err = statement.ExecContext(ctx, user.ID, user.Name, user.Email, user.PasswordHash, user.CreatedAt)
+	_, err = statement.ExecContext(ctx, user.ID, user.Name, user.Email, user.PasswordHash, user.DefaultRedirectType, user.CreatedAt)
 	if err != nil {
 		return err
 	}
@@ -49,7 +50,7 @@ func (u *userRepository) CreateUser(ctx context.Context, user *models.User) erro
 }
 
 func (u *userRepository) GetUserByID(ctx context.Context, id string) (*models.User, error) {
-	statement, err := u.db.PrepareContext(ctx, "SELECT id, name, email, password_hash, created_at FROM users WHERE id = ?")
+	statement, err := u.db.PrepareContext(ctx, "SELECT id, name, email, password_hash, default_redirect_type, created_at, disabled_at, email_verified_at, email_verification_sent_at FROM users WHERE id = ?")
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +60,7 @@ func (u *userRepository) GetUserByID(ctx context.Context, id string) (*models.Us
 }
 
 func (u *userRepository) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
-	statement, err := u.db.PrepareContext(ctx, "SELECT id, name, email, password_hash, created_at FROM users WHERE email = ?")
+	statement, err := u.db.PrepareContext(ctx, "SELECT id, name, email, password_hash, default_redirect_type, created_at, disabled_at, email_verified_at, email_verification_sent_at FROM users WHERE email = ?")
 	if err != nil {
 		return nil, err
 	}
@@ -69,13 +70,13 @@ func (u *userRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 }
 
 func (u *userRepository) UpdateUser(ctx context.Context, user *models.User) error {
-	statement, err := u.db.PrepareContext(ctx, "UPDATE users SET name = ?, email = ?, password_hash = ?, updated_at = ? WHERE id = ?")
+	statement, err := u.db.PrepareContext(ctx, "UPDATE users SET name = ?, email = ?, password_hash = ?, default_redirect_type = ?, updated_at = ?, email_verified_at = ?, email_verification_sent_at = ? WHERE id = ?")
 	if err != nil {
 		return err
 	}
 	defer statement.Close()
 
-	_, err = statement.ExecContext(ctx, user.Name, user.Email, user.PasswordHash, user.UpdatedAt, user.ID)
+	_, err = statement.ExecContext(ctx, user.Name, user.Email, user.PasswordHash, user.DefaultRedirectType, user.UpdatedAt, user.EmailVerifiedAt, user.EmailVerificationSentAt, user.ID)
 	if err != nil {
 		return err
 	}
@@ -98,9 +99,24 @@ func (u *userRepository) DeleteUser(ctx context.Context, id string) error {
 	return nil
 }
 
+func (u *userRepository) UpdateDisabledAt(ctx context.Context, id string, disabledAt sql.NullTime) error {
+	statement, err := u.db.PrepareContext(ctx, "UPDATE users SET disabled_at = ? WHERE id = ?")
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	_, err = statement.ExecContext(ctx, disabledAt, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func scanUser(row *sql.Row) (*models.User, error) {
 	var user models.User
-	err := row.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	err := row.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.DefaultRedirectType, &user.CreatedAt, &user.DisabledAt, &user.EmailVerifiedAt, &user.EmailVerificationSentAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
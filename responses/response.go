@@ -1,6 +1,7 @@
 package responses
 
 import (
+	"html/template"
 	"net/http"
 
 	jsoniter "github.com/json-iterator/go"
@@ -14,6 +15,22 @@ func JSON(w http.ResponseWriter, statusCode int, data any) {
 	}
 }
 
+func Error(w http.ResponseWriter, statusCode int, err error) {
+	JSON(w, statusCode, map[string]string{"error": err.Error()})
+}
+
 func NoContent(w http.ResponseWriter, statusCode int) {
 	w.WriteHeader(statusCode)
 }
+
+func HTML(w http.ResponseWriter, statusCode int, tmpl *template.Template, name string, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(statusCode)
+	tmpl.ExecuteTemplate(w, name, data)
+}
+
+func Data(w http.ResponseWriter, statusCode int, contentType string, data []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+	w.Write(data)
+}
@@ -13,3 +13,7 @@ func SetupRoutes(i *di.Injector) http.Handler {
 	r := mux.NewRouter()
 	return middlewares.Cors(routes.ConfigureRoutes(r, i))
 }
+
+func SetupInternalRoutes(i *di.Injector) http.Handler {
+	return routes.ConfigureInternalRoutes(mux.NewRouter(), i)
+}
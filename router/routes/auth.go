@@ -27,6 +27,12 @@ func GetAuthRoutes(i *di.Injector) []Route {
 			Handler:        authHandler.Login,
 			AllowAnonymous: true,
 		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/token/refresh",
+			Handler:        authHandler.RefreshToken,
+			AllowAnonymous: true,
+		},
 		{
 			Method:         http.MethodPost,
 			Path:           "/logout",
@@ -3,17 +3,22 @@ package routes
 import (
 	"log"
 	"net/http"
+	"strings"
 
 	"github.com/g-villarinho/link-fizz-api/handlers/middlewares"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/services"
 	"github.com/gorilla/mux"
 )
 
+const APIPrefix = "/v1"
+
 type Route struct {
 	Method         string
 	Path           string
 	Handler        http.HandlerFunc
 	AllowAnonymous bool
+	AdminOnly      bool
 }
 
 func ConfigureRoutes(r *mux.Router, i *di.Injector) *mux.Router {
@@ -22,12 +27,90 @@ func ConfigureRoutes(r *mux.Router, i *di.Injector) *mux.Router {
 		log.Fatal("Failed to create auth middleware:", err)
 	}
 
-	allRoutes := []Route{}
-	allRoutes = append(allRoutes, GetAuthRoutes(i)...)
-	allRoutes = append(allRoutes, GetUserRoutes(i)...)
-	allRoutes = append(allRoutes, GetLinkRoutes(i)...)
+	reservedCodes, err := di.Invoke[services.ReservedCodeRegistry](i)
+	if err != nil {
+		log.Fatal("Failed to create reserved code registry:", err)
+	}
+
+	apiRoutes := []Route{}
+	apiRoutes = append(apiRoutes, GetAuthRoutes(i)...)
+	apiRoutes = append(apiRoutes, GetEmailVerificationRoutes(i)...)
+	apiRoutes = append(apiRoutes, GetUserRoutes(i)...)
+	apiRoutes = append(apiRoutes, GetSessionRoutes(i)...)
+	apiRoutes = append(apiRoutes, GetLinkRoutes(i)...)
+	apiRoutes = append(apiRoutes, GetLinkRuleRoutes(i)...)
+	apiRoutes = append(apiRoutes, GetDomainRoutes(i)...)
+	apiRoutes = append(apiRoutes, GetTagRoutes(i)...)
+	apiRoutes = append(apiRoutes, GetFolderRoutes(i)...)
+
+	rootRoutes := []Route{}
+	rootRoutes = append(rootRoutes, GetJWKSRoutes(i)...)
+	rootRoutes = append(rootRoutes, GetRedirectRoutes(i)...)
+
+	reservedCodes.Reserve(ReservedSegments(APIPrefix, apiRoutes)...)
+	reservedCodes.Reserve(ReservedSegments("", rootRoutes)...)
+
+	registerRoutes(r.PathPrefix(APIPrefix).Subrouter(), apiRoutes, authMiddleware)
+	registerRoutes(r, rootRoutes, authMiddleware)
+
+	return r
+}
 
-	for _, route := range allRoutes {
+func ConfigureInternalRoutes(r *mux.Router, i *di.Injector) *mux.Router {
+	adminMiddleware, err := di.Invoke[middlewares.AdminMiddleware](i)
+	if err != nil {
+		log.Fatal("Failed to create admin middleware:", err)
+	}
+
+	for _, route := range GetInternalRoutes(i) {
+		var handler http.Handler = route.Handler
+
+		if route.AdminOnly {
+			handler = adminMiddleware.Authenticate(handler)
+		}
+
+		r.Handle(route.Path, handler).Methods(route.Method)
+	}
+
+	return r
+}
+
+func ReservedSegments(prefix string, routes []Route) []string {
+	segments := []string{}
+	shortCodeParents := []string{}
+
+	for _, route := range routes {
+		if parent, _, found := strings.Cut(route.Path, "/{shortCode}"); found && parent != "" {
+			shortCodeParents = append(shortCodeParents, parent+"/")
+		}
+	}
+
+	for _, route := range routes {
+		path := strings.TrimPrefix(prefix+route.Path, "/")
+		segment, _, _ := strings.Cut(path, "/")
+
+		if segment != "" && !strings.HasPrefix(segment, "{") {
+			segments = append(segments, segment)
+		}
+
+		for _, parent := range shortCodeParents {
+			rest, found := strings.CutPrefix(route.Path, parent)
+			if !found {
+				continue
+			}
+
+			sibling, _, _ := strings.Cut(rest, "/")
+			if sibling != "" && !strings.HasPrefix(sibling, "{") {
+				segments = append(segments, sibling)
+			}
+		}
+	}
+
+	return segments
+}
+
+func registerRoutes(r *mux.Router, routes []Route, authMiddleware middlewares.AuthMiddleware) {
+	for _, route := range routes {
 		var handler http.Handler = route.Handler
 
 		if !route.AllowAnonymous {
@@ -38,6 +121,4 @@ func ConfigureRoutes(r *mux.Router, i *di.Injector) *mux.Router {
 			handler.ServeHTTP(w, r)
 		}).Methods(route.Method)
 	}
-
-	return r
 }
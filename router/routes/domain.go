@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/handlers"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+func GetDomainRoutes(i *di.Injector) []Route {
+	domainHandler, err := di.Invoke[handlers.DomainHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject domain handler:", err)
+	}
+
+	return []Route{
+		{
+			Method:         http.MethodGet,
+			Path:           "/me/domains",
+			Handler:        domainHandler.GetDomains,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/me/domains",
+			Handler:        domainHandler.CreateDomain,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/me/domains/{id}/verify",
+			Handler:        domainHandler.VerifyDomain,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodDelete,
+			Path:           "/me/domains/{id}",
+			Handler:        domainHandler.DeleteDomain,
+			AllowAnonymous: false,
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/handlers"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+func GetEmailVerificationRoutes(i *di.Injector) []Route {
+	emailVerificationHandler, err := di.Invoke[handlers.EmailVerificationHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject email verification handler:", err)
+	}
+
+	return []Route{
+		{
+			Method:         http.MethodGet,
+			Path:           "/verify-email",
+			Handler:        emailVerificationHandler.VerifyEmail,
+			AllowAnonymous: true,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/verify-email/resend",
+			Handler:        emailVerificationHandler.ResendVerification,
+			AllowAnonymous: false,
+		},
+	}
+}
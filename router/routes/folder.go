@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/handlers"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+func GetFolderRoutes(i *di.Injector) []Route {
+	folderHandler, err := di.Invoke[handlers.FolderHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject folder handler:", err)
+	}
+
+	return []Route{
+		{
+			Method:         http.MethodGet,
+			Path:           "/me/folders",
+			Handler:        folderHandler.GetFolders,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/me/folders",
+			Handler:        folderHandler.CreateFolder,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPut,
+			Path:           "/me/folders/{id}",
+			Handler:        folderHandler.UpdateFolder,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodDelete,
+			Path:           "/me/folders/{id}",
+			Handler:        folderHandler.DeleteFolder,
+			AllowAnonymous: false,
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/handlers"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+// GetInternalRoutes are served only on the internal listener, never on the public API router.
+// Routes that change account state additionally require the ADMIN_TOKEN bearer secret.
+func GetInternalRoutes(i *di.Injector) []Route {
+	metricsHandler, err := di.Invoke[handlers.MetricsHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject metrics handler:", err)
+	}
+
+	userStatusHandler, err := di.Invoke[handlers.UserStatusHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject user status handler:", err)
+	}
+
+	return []Route{
+		{
+			Method:         http.MethodGet,
+			Path:           "/metrics/visits",
+			Handler:        metricsHandler.GetVisitRecorderStats,
+			AllowAnonymous: true,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/users/{id}/disable",
+			Handler:        userStatusHandler.DisableUser,
+			AllowAnonymous: true,
+			AdminOnly:      true,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/users/{id}/enable",
+			Handler:        userStatusHandler.EnableUser,
+			AllowAnonymous: true,
+			AdminOnly:      true,
+		},
+	}
+}
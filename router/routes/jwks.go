@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/handlers"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+func GetJWKSRoutes(i *di.Injector) []Route {
+	jwksHandler, err := di.Invoke[handlers.JWKSHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject jwks handler:", err)
+	}
+
+	return []Route{
+		{
+			Method:         http.MethodGet,
+			Path:           "/.well-known/jwks.json",
+			Handler:        jwksHandler.GetJWKS,
+			AllowAnonymous: true,
+		},
+	}
+}
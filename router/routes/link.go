@@ -22,10 +22,16 @@ func GetLinkRoutes(i *di.Injector) []Route {
 			AllowAnonymous: false,
 		},
 		{
-			Method:         http.MethodGet,
-			Path:           "/{shortCode}",
-			Handler:        linkHandler.RedirectLink,
-			AllowAnonymous: true,
+			Method:         http.MethodPost,
+			Path:           "/links/batch",
+			Handler:        linkHandler.CreateLinksBatch,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/links/import",
+			Handler:        linkHandler.ImportLinks,
+			AllowAnonymous: false,
 		},
 		{
 			Method:         http.MethodGet,
@@ -33,11 +39,75 @@ func GetLinkRoutes(i *di.Injector) []Route {
 			Handler:        linkHandler.GetLinks,
 			AllowAnonymous: false,
 		},
+		{
+			Method:         http.MethodGet,
+			Path:           "/me/links/export",
+			Handler:        linkHandler.ExportLinks,
+			AllowAnonymous: false,
+		},
 		{
 			Method:         http.MethodGet,
 			Path:           "/me/links/{shortCode}",
 			Handler:        linkHandler.GetLinkDetails,
 			AllowAnonymous: false,
 		},
+		{
+			Method:         http.MethodPut,
+			Path:           "/me/links/{shortCode}",
+			Handler:        linkHandler.UpdateLink,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPatch,
+			Path:           "/me/links/{shortCode}",
+			Handler:        linkHandler.UpdateLink,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodDelete,
+			Path:           "/me/links/{shortCode}",
+			Handler:        linkHandler.DeleteLink,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodGet,
+			Path:           "/me/links/{shortCode}/stats",
+			Handler:        linkHandler.GetLinkStats,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodGet,
+			Path:           "/me/links/{shortCode}/qr",
+			Handler:        linkHandler.GetLinkQRCode,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodGet,
+			Path:           "/me/links/{shortCode}/visits/export",
+			Handler:        linkHandler.ExportLinkVisits,
+			AllowAnonymous: false,
+		},
+	}
+}
+
+func GetRedirectRoutes(i *di.Injector) []Route {
+	linkHandler, err := di.Invoke[handlers.LinkHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject link handler:", err)
+	}
+
+	return []Route{
+		{
+			Method:         http.MethodGet,
+			Path:           "/{shortCode}",
+			Handler:        linkHandler.RedirectLink,
+			AllowAnonymous: true,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/{shortCode}",
+			Handler:        linkHandler.UnlockLink,
+			AllowAnonymous: true,
+		},
 	}
 }
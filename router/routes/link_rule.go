@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/handlers"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+func GetLinkRuleRoutes(i *di.Injector) []Route {
+	linkRuleHandler, err := di.Invoke[handlers.LinkRuleHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject link rule handler:", err)
+	}
+
+	return []Route{
+		{
+			Method:         http.MethodGet,
+			Path:           "/me/links/{shortCode}/rules",
+			Handler:        linkRuleHandler.GetLinkRules,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/me/links/{shortCode}/rules",
+			Handler:        linkRuleHandler.CreateLinkRule,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPut,
+			Path:           "/me/links/{shortCode}/rules/{id}",
+			Handler:        linkRuleHandler.UpdateLinkRule,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodDelete,
+			Path:           "/me/links/{shortCode}/rules/{id}",
+			Handler:        linkRuleHandler.DeleteLinkRule,
+			AllowAnonymous: false,
+		},
+	}
+}
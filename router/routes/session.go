@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/handlers"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+func GetSessionRoutes(i *di.Injector) []Route {
+	sessionHandler, err := di.Invoke[handlers.SessionHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject session handler:", err)
+	}
+
+	return []Route{
+		{
+			Method:         http.MethodGet,
+			Path:           "/me/sessions",
+			Handler:        sessionHandler.GetSessions,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodDelete,
+			Path:           "/me/sessions",
+			Handler:        sessionHandler.RevokeOtherSessions,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodDelete,
+			Path:           "/me/sessions/{id}",
+			Handler:        sessionHandler.RevokeSession,
+			AllowAnonymous: false,
+		},
+	}
+}
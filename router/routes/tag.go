@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/g-villarinho/link-fizz-api/handlers"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+func GetTagRoutes(i *di.Injector) []Route {
+	tagHandler, err := di.Invoke[handlers.TagHandler](i)
+	if err != nil {
+		log.Fatal("failed to inject tag handler:", err)
+	}
+
+	return []Route{
+		{
+			Method:         http.MethodGet,
+			Path:           "/me/tags",
+			Handler:        tagHandler.GetTags,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPost,
+			Path:           "/me/tags",
+			Handler:        tagHandler.CreateTag,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodPut,
+			Path:           "/me/tags/{id}",
+			Handler:        tagHandler.UpdateTag,
+			AllowAnonymous: false,
+		},
+		{
+			Method:         http.MethodDelete,
+			Path:           "/me/tags/{id}",
+			Handler:        tagHandler.DeleteTag,
+			AllowAnonymous: false,
+		},
+	}
+}
@@ -39,5 +39,11 @@ func GetUserRoutes(i *di.Injector) []Route {
 			Handler:        userHandler.UpdatePassword,
 			AllowAnonymous: false,
 		},
+		{
+			Method:         http.MethodPatch,
+			Path:           "/users/preferences",
+			Handler:        userHandler.UpdatePreferences,
+			AllowAnonymous: false,
+		},
 	}
 }
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/g-villarinho/link-fizz-api/models"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
@@ -11,6 +12,7 @@ import (
 type AuthService interface {
 	Register(ctx context.Context, name, email, password, ipAdress, userAgent string) (*models.LoginResponse, error)
 	Login(ctx context.Context, email, password, ipAdress, userAgent string) (*models.LoginResponse, error)
+	RefreshToken(ctx context.Context, refreshToken, ipAdress, userAgent string) (*models.LoginResponse, error)
 	Logout(ctx context.Context, userID, sessionID, token string) error
 }
 
@@ -20,6 +22,7 @@ type authService struct {
 	ss  SessionService
 	ls  LogoutService
 	us  UserService
+	evs EmailVerificationService
 }
 
 func NewAuthService(i *di.Injector) (AuthService, error) {
@@ -43,12 +46,18 @@ func NewAuthService(i *di.Injector) (AuthService, error) {
 		return nil, fmt.Errorf("invoke services.UserService: %w", err)
 	}
 
+	emailVerificationService, err := di.Invoke[EmailVerificationService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.EmailVerificationService: %w", err)
+	}
+
 	return &authService{
 		i:   i,
 		scs: securityService,
 		ss:  sessionService,
 		ls:  logoutService,
 		us:  userService,
+		evs: emailVerificationService,
 	}, nil
 }
 
@@ -58,14 +67,17 @@ func (l *authService) Register(ctx context.Context, name, email, password, ipAdr
 		return nil, err
 	}
 
-	token, err := l.ss.CreateSession(ctx, userID, ipAdress, userAgent)
+	// The account is usable right away; a failed delivery can be retried through the resend endpoint.
+	if err := l.evs.SendVerification(ctx, userID); err != nil {
+		slog.Warn("send verification email", "error", err, "user_id", userID)
+	}
+
+	response, err := l.ss.CreateSession(ctx, userID, ipAdress, userAgent)
 	if err != nil {
 		return nil, fmt.Errorf("create session: %w", err)
 	}
 
-	return &models.LoginResponse{
-		Token: token,
-	}, nil
+	return response, nil
 }
 
 func (l *authService) Login(ctx context.Context, email, password, ipAdress, userAgent string) (*models.LoginResponse, error) {
@@ -78,14 +90,29 @@ func (l *authService) Login(ctx context.Context, email, password, ipAdress, user
 		return nil, models.ErrInvalidCredentials
 	}
 
-	token, err := l.ss.CreateSession(ctx, user.ID, ipAdress, userAgent)
+	if user.DisabledAt.Valid {
+		return nil, models.ErrUserDisabled
+	}
+
+	response, err := l.ss.CreateSession(ctx, user.ID, ipAdress, userAgent)
 	if err != nil {
 		return nil, fmt.Errorf("create session: %w", err)
 	}
 
-	return &models.LoginResponse{
-		Token: token,
-	}, nil
+	return response, nil
+}
+
+func (l *authService) RefreshToken(ctx context.Context, refreshToken, ipAdress, userAgent string) (*models.LoginResponse, error) {
+	response, err := l.ss.RefreshSession(ctx, refreshToken, ipAdress, userAgent)
+	if err != nil {
+		if err == models.ErrInvalidRefreshToken || err == models.ErrRefreshTokenReused || err == models.ErrUserDisabled {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("refresh session: %w", err)
+	}
+
+	return response, nil
 }
 
 func (l *authService) Logout(ctx context.Context, userID, sessionID, token string) error {
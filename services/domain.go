@@ -0,0 +1,245 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/repositories"
+	"github.com/google/uuid"
+)
+
+var hostnamePattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z][a-z0-9-]{0,62}$`)
+
+type DNSResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+func NewDNSResolver(i *di.Injector) (DNSResolver, error) {
+	return net.DefaultResolver, nil
+}
+
+type DomainService interface {
+	CreateDomain(ctx context.Context, userID string, payload models.DomainPayload) (*models.DomainResponse, error)
+	GetDomains(ctx context.Context, userID string) ([]models.DomainResponse, error)
+	VerifyDomain(ctx context.Context, userID, domainID string) (*models.DomainResponse, error)
+	DeleteDomain(ctx context.Context, userID, domainID string) error
+	GetVerifiedDomain(ctx context.Context, userID, hostname string) (*models.Domain, error)
+}
+
+type domainService struct {
+	i        *di.Injector
+	dr       repositories.DomainRepository
+	resolver DNSResolver
+}
+
+func NewDomainService(i *di.Injector) (DomainService, error) {
+	domainRepository, err := di.Invoke[repositories.DomainRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.DomainRepository: %w", err)
+	}
+
+	resolver, err := di.Invoke[DNSResolver](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.DNSResolver: %w", err)
+	}
+
+	return &domainService{
+		i:        i,
+		dr:       domainRepository,
+		resolver: resolver,
+	}, nil
+}
+
+func (d *domainService) CreateDomain(ctx context.Context, userID string, payload models.DomainPayload) (*models.DomainResponse, error) {
+	hostname, err := normalizeHostname(payload.Hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pending claims don't reserve the hostname; only a verified one does, and
+	// the first claim to verify wins.
+	verified, err := d.dr.GetVerifiedDomainByHostname(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("get verified domain by hostname: %w", err)
+	}
+
+	if verified != nil {
+		return nil, models.ErrDomainAlreadyExists
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, fmt.Errorf("generate verification token: %w", err)
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("generate UUID: %w", err)
+	}
+
+	domain := models.Domain{
+		ID:                id.String(),
+		UserID:            userID,
+		Hostname:          hostname,
+		VerificationToken: hex.EncodeToString(token),
+		CreatedAt:         time.Now().UTC(),
+	}
+
+	if err := d.dr.CreateDomain(ctx, domain); err != nil {
+		if err == models.ErrDomainAlreadyExists {
+			return nil, err
+		}
+		return nil, fmt.Errorf("create domain: %w", err)
+	}
+
+	response := domain.ToResponse()
+	return &response, nil
+}
+
+func (d *domainService) GetDomains(ctx context.Context, userID string) ([]models.DomainResponse, error) {
+	domains, err := d.dr.GetDomainsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get domains by user ID: %w", err)
+	}
+
+	domainResponses := make([]models.DomainResponse, len(domains))
+	for i, domain := range domains {
+		domainResponses[i] = domain.ToResponse()
+	}
+
+	return domainResponses, nil
+}
+
+func (d *domainService) VerifyDomain(ctx context.Context, userID, domainID string) (*models.DomainResponse, error) {
+	domain, err := d.getUserDomain(ctx, userID, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !domain.IsVerified() {
+		records, err := d.resolver.LookupTXT(ctx, domain.VerificationRecordName())
+		if err != nil {
+			var dnsErr *net.DNSError
+			if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+				return nil, models.ErrDomainVerificationFailed
+			}
+			return nil, fmt.Errorf("lookup TXT record: %w", err)
+		}
+
+		if !containsRecord(records, domain.VerificationRecordValue()) {
+			return nil, models.ErrDomainVerificationFailed
+		}
+
+		verifiedAt := time.Now().UTC()
+		if err := d.dr.MarkDomainVerified(ctx, domain.ID, verifiedAt); err != nil {
+			if err == models.ErrDomainAlreadyExists {
+				return nil, err
+			}
+			return nil, fmt.Errorf("mark domain verified: %w", err)
+		}
+		domain.VerifiedAt = sql.NullTime{Time: verifiedAt, Valid: true}
+	}
+
+	response := domain.ToResponse()
+	return &response, nil
+}
+
+func (d *domainService) DeleteDomain(ctx context.Context, userID, domainID string) error {
+	domain, err := d.getUserDomain(ctx, userID, domainID)
+	if err != nil {
+		return err
+	}
+
+	if err := d.dr.DeleteDomain(ctx, domain.ID); err != nil {
+		if err == models.ErrDomainInUse {
+			return err
+		}
+		return fmt.Errorf("delete domain: %w", err)
+	}
+
+	return nil
+}
+
+func (d *domainService) GetVerifiedDomain(ctx context.Context, userID, hostname string) (*models.Domain, error) {
+	hostname, err := normalizeHostname(hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := d.dr.GetVerifiedDomainByHostname(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("get verified domain by hostname: %w", err)
+	}
+
+	if domain == nil {
+		return nil, models.ErrDomainNotVerified
+	}
+
+	if domain.UserID != userID {
+		return nil, models.ErrDomainNotBelongToUser
+	}
+
+	return domain, nil
+}
+
+func (d *domainService) getUserDomain(ctx context.Context, userID, domainID string) (*models.Domain, error) {
+	domain, err := d.dr.GetDomainByID(ctx, domainID)
+	if err != nil {
+		return nil, fmt.Errorf("get domain by ID: %w", err)
+	}
+
+	if domain == nil {
+		return nil, models.ErrDomainNotFound
+	}
+
+	if domain.UserID != userID {
+		return nil, models.ErrDomainNotBelongToUser
+	}
+
+	return domain, nil
+}
+
+func normalizeHostname(hostname string) (string, error) {
+	hostname = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(hostname)), ".")
+
+	if len(hostname) > 253 || !hostnamePattern.MatchString(hostname) {
+		return "", models.ErrInvalidDomain
+	}
+
+	if strings.EqualFold(hostname, defaultHostname()) {
+		return "", models.ErrInvalidDomain
+	}
+
+	return hostname, nil
+}
+
+func defaultHostname() string {
+	apiURL, err := url.Parse(config.Env.APIURL)
+	if err != nil {
+		return ""
+	}
+
+	return apiURL.Hostname()
+}
+
+func containsRecord(records []string, value string) bool {
+	for _, record := range records {
+		if strings.TrimSpace(record) == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateDomain(t *testing.T) {
+	t.Run("when the hostname is valid, it should return the TXT record to publish", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		service := &domainService{dr: mockRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockRepo.On("GetVerifiedDomainByHostname", ctx, "go.acme.com").Return(nil, nil)
+		mockRepo.On("CreateDomain", ctx, mock.MatchedBy(func(d models.Domain) bool {
+			return d.Hostname == "go.acme.com" && d.UserID == userID && len(d.VerificationToken) == 32
+		})).Return(nil)
+
+		response, err := service.CreateDomain(ctx, userID, models.DomainPayload{Hostname: " Go.Acme.com. "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "go.acme.com", response.Hostname)
+		assert.False(t, response.Verified)
+		assert.Equal(t, "TXT", response.Verification.Type)
+		assert.Equal(t, "_link-fizz.go.acme.com", response.Verification.Name)
+		assert.Contains(t, response.Verification.Value, models.DomainVerificationValue)
+	})
+
+	t.Run("when the hostname is invalid, it should return ErrInvalidDomain", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		service := &domainService{dr: mockRepo}
+
+		for _, hostname := range []string{"", "localhost", "https://go.acme.com", "go.acme.com:8080", "-bad.acme.com"} {
+			_, err := service.CreateDomain(context.Background(), uuid.New().String(), models.DomainPayload{Hostname: hostname})
+			assert.Equal(t, models.ErrInvalidDomain, err, hostname)
+		}
+
+		mockRepo.AssertNotCalled(t, "CreateDomain", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when another user has verified the hostname, it should return ErrDomainAlreadyExists", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		service := &domainService{dr: mockRepo}
+
+		ctx := context.Background()
+		verified := &models.Domain{UserID: uuid.New().String(), Hostname: "go.acme.com", VerifiedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+
+		mockRepo.On("GetVerifiedDomainByHostname", ctx, "go.acme.com").Return(verified, nil)
+
+		_, err := service.CreateDomain(ctx, uuid.New().String(), models.DomainPayload{Hostname: "go.acme.com"})
+
+		assert.Equal(t, models.ErrDomainAlreadyExists, err)
+		mockRepo.AssertNotCalled(t, "CreateDomain", mock.Anything, mock.Anything)
+	})
+}
+
+func TestVerifyDomain(t *testing.T) {
+	t.Run("when the TXT record is published, it should mark the domain as verified", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		mockResolver := new(mocks.DNSResolverMock)
+		service := &domainService{dr: mockRepo, resolver: mockResolver}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		domain := &models.Domain{ID: uuid.New().String(), UserID: userID, Hostname: "go.acme.com", VerificationToken: "token"}
+
+		mockRepo.On("GetDomainByID", ctx, domain.ID).Return(domain, nil)
+		mockResolver.On("LookupTXT", ctx, "_link-fizz.go.acme.com").Return([]string{"v=spf1 -all", "link-fizz-verification=token"}, nil)
+		mockRepo.On("MarkDomainVerified", ctx, domain.ID, mock.AnythingOfType("time.Time")).Return(nil)
+
+		response, err := service.VerifyDomain(ctx, userID, domain.ID)
+
+		assert.NoError(t, err)
+		assert.True(t, response.Verified)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the TXT record is missing, it should return ErrDomainVerificationFailed", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		mockResolver := new(mocks.DNSResolverMock)
+		service := &domainService{dr: mockRepo, resolver: mockResolver}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		domain := &models.Domain{ID: uuid.New().String(), UserID: userID, Hostname: "go.acme.com", VerificationToken: "token"}
+
+		mockRepo.On("GetDomainByID", ctx, domain.ID).Return(domain, nil)
+		mockResolver.On("LookupTXT", ctx, "_link-fizz.go.acme.com").Return(nil, &net.DNSError{Err: "no such host", IsNotFound: true})
+
+		_, err := service.VerifyDomain(ctx, userID, domain.ID)
+
+		assert.Equal(t, models.ErrDomainVerificationFailed, err)
+		mockRepo.AssertNotCalled(t, "MarkDomainVerified", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the TXT record has another token, it should return ErrDomainVerificationFailed", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		mockResolver := new(mocks.DNSResolverMock)
+		service := &domainService{dr: mockRepo, resolver: mockResolver}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		domain := &models.Domain{ID: uuid.New().String(), UserID: userID, Hostname: "go.acme.com", VerificationToken: "token"}
+
+		mockRepo.On("GetDomainByID", ctx, domain.ID).Return(domain, nil)
+		mockResolver.On("LookupTXT", ctx, "_link-fizz.go.acme.com").Return([]string{"link-fizz-verification=other"}, nil)
+
+		_, err := service.VerifyDomain(ctx, userID, domain.ID)
+
+		assert.Equal(t, models.ErrDomainVerificationFailed, err)
+	})
+
+	t.Run("when another claim verified the hostname first, it should return ErrDomainAlreadyExists", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		mockResolver := new(mocks.DNSResolverMock)
+		service := &domainService{dr: mockRepo, resolver: mockResolver}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		domain := &models.Domain{ID: uuid.New().String(), UserID: userID, Hostname: "go.acme.com", VerificationToken: "token"}
+
+		mockRepo.On("GetDomainByID", ctx, domain.ID).Return(domain, nil)
+		mockResolver.On("LookupTXT", ctx, "_link-fizz.go.acme.com").Return([]string{"link-fizz-verification=token"}, nil)
+		mockRepo.On("MarkDomainVerified", ctx, domain.ID, mock.AnythingOfType("time.Time")).Return(models.ErrDomainAlreadyExists)
+
+		_, err := service.VerifyDomain(ctx, userID, domain.ID)
+
+		assert.Equal(t, models.ErrDomainAlreadyExists, err)
+	})
+
+	t.Run("when the domain belongs to another user, it should return ErrDomainNotBelongToUser", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		mockResolver := new(mocks.DNSResolverMock)
+		service := &domainService{dr: mockRepo, resolver: mockResolver}
+
+		ctx := context.Background()
+		domain := &models.Domain{ID: uuid.New().String(), UserID: uuid.New().String(), Hostname: "go.acme.com"}
+
+		mockRepo.On("GetDomainByID", ctx, domain.ID).Return(domain, nil)
+
+		_, err := service.VerifyDomain(ctx, uuid.New().String(), domain.ID)
+
+		assert.Equal(t, models.ErrDomainNotBelongToUser, err)
+		mockResolver.AssertNotCalled(t, "LookupTXT", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetVerifiedDomain(t *testing.T) {
+	t.Run("when the domain is not verified, it should return ErrDomainNotVerified", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		service := &domainService{dr: mockRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockRepo.On("GetVerifiedDomainByHostname", ctx, "go.acme.com").Return(nil, nil)
+
+		_, err := service.GetVerifiedDomain(ctx, userID, "go.acme.com")
+
+		assert.Equal(t, models.ErrDomainNotVerified, err)
+	})
+
+	t.Run("when the domain is verified and owned, it should return it", func(t *testing.T) {
+		mockRepo := new(mocks.DomainRepositoryMock)
+		service := &domainService{dr: mockRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		domain := &models.Domain{UserID: userID, Hostname: "go.acme.com", VerifiedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+
+		mockRepo.On("GetVerifiedDomainByHostname", ctx, "go.acme.com").Return(domain, nil)
+
+		result, err := service.GetVerifiedDomain(ctx, userID, "GO.acme.com")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain, result)
+	})
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/repositories"
+)
+
+type EmailVerificationService interface {
+	SendVerification(ctx context.Context, userID string) error
+	ResendVerification(ctx context.Context, userID string) error
+	VerifyEmail(ctx context.Context, token string) error
+}
+
+type emailVerificationService struct {
+	i  *di.Injector
+	ts TokenService
+	m  Mailer
+	ur repositories.UserRepository
+}
+
+func NewEmailVerificationService(i *di.Injector) (EmailVerificationService, error) {
+	tokenService, err := di.Invoke[TokenService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.Token: %w", err)
+	}
+
+	mailer, err := di.Invoke[Mailer](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.Mailer: %w", err)
+	}
+
+	userRepository, err := di.Invoke[repositories.UserRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.User: %w", err)
+	}
+
+	return &emailVerificationService{
+		i:  i,
+		ts: tokenService,
+		m:  mailer,
+		ur: userRepository,
+	}, nil
+}
+
+func (e *emailVerificationService) SendVerification(ctx context.Context, userID string) error {
+	user, err := e.getUnverifiedUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return e.sendVerification(ctx, user, time.Now().UTC())
+}
+
+func (e *emailVerificationService) ResendVerification(ctx context.Context, userID string) error {
+	user, err := e.getUnverifiedUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if user.EmailVerificationSentAt.Valid && now.Before(user.EmailVerificationSentAt.Time.Add(config.Env.EmailVerification.ResendInterval)) {
+		return models.ErrVerificationResendTooSoon
+	}
+
+	return e.sendVerification(ctx, user, now)
+}
+
+func (e *emailVerificationService) VerifyEmail(ctx context.Context, token string) error {
+	logger := slog.With(
+		"service", "email_verification",
+		"method", "VerifyEmail",
+	)
+
+	claims, err := e.ts.ValidateEmailVerificationToken(ctx, token)
+	if err != nil {
+		logger.Warn("invalid verification token", "error", err)
+		return models.ErrInvalidVerificationToken
+	}
+
+	user, err := e.ur.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		return fmt.Errorf("get user by ID %s: %w", claims.UserID, err)
+	}
+
+	// A token issued for a previous address must not verify the current one.
+	if user == nil || user.Email != claims.Email {
+		return models.ErrInvalidVerificationToken
+	}
+
+	if user.EmailVerifiedAt.Valid {
+		return nil
+	}
+
+	user.EmailVerifiedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+
+	if err := e.ur.UpdateUser(ctx, user); err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+
+	return nil
+}
+
+func (e *emailVerificationService) getUnverifiedUser(ctx context.Context, userID string) (*models.User, error) {
+	user, err := e.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user by ID %s: %w", userID, err)
+	}
+
+	if user == nil {
+		return nil, models.ErrUserNotFound
+	}
+
+	if user.EmailVerifiedAt.Valid {
+		return nil, models.ErrEmailAlreadyVerified
+	}
+
+	return user, nil
+}
+
+func (e *emailVerificationService) sendVerification(ctx context.Context, user *models.User, now time.Time) error {
+	token, err := e.ts.GenerateEmailVerificationToken(ctx, user.ID, user.Email, now, now.Add(config.Env.EmailVerification.TTL))
+	if err != nil {
+		return fmt.Errorf("generate verification token: %w", err)
+	}
+
+	verificationURL, err := url.Parse(config.Env.EmailVerification.URL)
+	if err != nil {
+		return fmt.Errorf("parse verification url: %w", err)
+	}
+
+	query := verificationURL.Query()
+	query.Set("token", token)
+	verificationURL.RawQuery = query.Encode()
+
+	email := models.Email{
+		To:      user.Email,
+		Subject: "Confirm your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nConfirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s. If you did not create a Link Fizz account, you can ignore this message.\n",
+			user.Name, verificationURL.String(), config.Env.EmailVerification.TTL,
+		),
+	}
+
+	if err := e.m.Send(ctx, email); err != nil {
+		return fmt.Errorf("send verification email: %w", err)
+	}
+
+	user.EmailVerificationSentAt = sql.NullTime{Time: now, Valid: true}
+
+	if err := e.ur.UpdateUser(ctx, user); err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setEmailVerification(t *testing.T, cfg models.EmailVerification) {
+	t.Helper()
+
+	previous := config.Env.EmailVerification
+	config.Env.EmailVerification = cfg
+	t.Cleanup(func() { config.Env.EmailVerification = previous })
+}
+
+func newTestEmailVerificationService(t *testing.T, mockUserRepo *mocks.UserRepositoryMock) (*emailVerificationService, *memoryMailer) {
+	t.Helper()
+
+	mailer := newMemoryMailer()
+	service := &emailVerificationService{
+		ts: newTestTokenService(t, models.KeyRing{Keys: []models.Key{generateTestKey(t, "test")}}),
+		m:  mailer,
+		ur: mockUserRepo,
+	}
+
+	return service, mailer
+}
+
+func verificationToken(t *testing.T, email models.Email) string {
+	t.Helper()
+
+	for _, line := range strings.Split(email.Body, "\n") {
+		if link, err := url.Parse(line); err == nil && link.Query().Get("token") != "" {
+			return link.Query().Get("token")
+		}
+	}
+
+	t.Fatal("verification link not found in email body")
+	return ""
+}
+
+func TestSendVerification(t *testing.T) {
+	t.Run("when the user is not verified, it should email a verification link and record when it was sent", func(t *testing.T) {
+		setEmailVerification(t, models.EmailVerification{URL: "https://app.example.com/verify?lang=en", TTL: time.Hour})
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		service, mailer := newTestEmailVerificationService(t, mockUserRepo)
+
+		ctx := context.Background()
+		user := &models.User{ID: uuid.New().String(), Name: "Ana", Email: "ana@example.com"}
+
+		mockUserRepo.On("GetUserByID", ctx, user.ID).Return(user, nil)
+		mockUserRepo.On("UpdateUser", ctx, mock.MatchedBy(func(u *models.User) bool {
+			return u.EmailVerificationSentAt.Valid && !u.EmailVerifiedAt.Valid
+		})).Return(nil)
+
+		err := service.SendVerification(ctx, user.ID)
+
+		assert.NoError(t, err)
+		emails := mailer.Emails()
+		assert.Len(t, emails, 1)
+		assert.Equal(t, "ana@example.com", emails[0].To)
+		assert.Contains(t, emails[0].Body, "https://app.example.com/verify?lang=en&token=")
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the email is already verified, it should return ErrEmailAlreadyVerified", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		service, mailer := newTestEmailVerificationService(t, mockUserRepo)
+
+		ctx := context.Background()
+		user := &models.User{ID: uuid.New().String(), EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+
+		mockUserRepo.On("GetUserByID", ctx, user.ID).Return(user, nil)
+
+		err := service.SendVerification(ctx, user.ID)
+
+		assert.Equal(t, models.ErrEmailAlreadyVerified, err)
+		assert.Empty(t, mailer.Emails())
+	})
+}
+
+func TestResendVerification(t *testing.T) {
+	t.Run("when the last email was sent within the resend interval, it should return ErrVerificationResendTooSoon", func(t *testing.T) {
+		setEmailVerification(t, models.EmailVerification{ResendInterval: time.Minute})
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		service, mailer := newTestEmailVerificationService(t, mockUserRepo)
+
+		ctx := context.Background()
+		user := &models.User{ID: uuid.New().String(), Email: "ana@example.com", EmailVerificationSentAt: sql.NullTime{Time: time.Now().UTC(), Valid: true}}
+
+		mockUserRepo.On("GetUserByID", ctx, user.ID).Return(user, nil)
+
+		err := service.ResendVerification(ctx, user.ID)
+
+		assert.Equal(t, models.ErrVerificationResendTooSoon, err)
+		assert.Empty(t, mailer.Emails())
+	})
+}
+
+func TestVerifyEmail(t *testing.T) {
+	setEmailVerification(t, models.EmailVerification{URL: "http://localhost/v1/verify-email", TTL: time.Hour})
+
+	t.Run("when the token is valid, it should mark the email as verified", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		service, mailer := newTestEmailVerificationService(t, mockUserRepo)
+
+		ctx := context.Background()
+		user := &models.User{ID: uuid.New().String(), Email: "ana@example.com"}
+
+		mockUserRepo.On("GetUserByID", ctx, user.ID).Return(user, nil)
+		mockUserRepo.On("UpdateUser", ctx, mock.Anything).Return(nil)
+
+		assert.NoError(t, service.SendVerification(ctx, user.ID))
+
+		err := service.VerifyEmail(ctx, verificationToken(t, mailer.Emails()[0]))
+
+		assert.NoError(t, err)
+		assert.True(t, user.EmailVerifiedAt.Valid)
+	})
+
+	t.Run("when the email changed after the token was issued, it should return ErrInvalidVerificationToken", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		service, _ := newTestEmailVerificationService(t, mockUserRepo)
+
+		ctx := context.Background()
+		user := &models.User{ID: uuid.New().String(), Email: "new@example.com"}
+		now := time.Now()
+
+		token, err := service.ts.GenerateEmailVerificationToken(ctx, user.ID, "old@example.com", now, now.Add(time.Hour))
+		assert.NoError(t, err)
+
+		mockUserRepo.On("GetUserByID", ctx, user.ID).Return(user, nil)
+
+		err = service.VerifyEmail(ctx, token)
+
+		assert.Equal(t, models.ErrInvalidVerificationToken, err)
+		mockUserRepo.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the token has expired, it should return ErrInvalidVerificationToken", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		service, _ := newTestEmailVerificationService(t, mockUserRepo)
+
+		ctx := context.Background()
+		issuedAt := time.Now().Add(-2 * time.Hour)
+
+		token, err := service.ts.GenerateEmailVerificationToken(ctx, uuid.New().String(), "ana@example.com", issuedAt, issuedAt.Add(time.Hour))
+		assert.NoError(t, err)
+
+		err = service.VerifyEmail(ctx, token)
+
+		assert.Equal(t, models.ErrInvalidVerificationToken, err)
+		mockUserRepo.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when an access token is used, it should return ErrInvalidVerificationToken", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		service, _ := newTestEmailVerificationService(t, mockUserRepo)
+
+		ctx := context.Background()
+		now := time.Now()
+
+		token, err := service.ts.GenerateToken(ctx, uuid.New().String(), uuid.New().String(), now, now.Add(time.Hour))
+		assert.NoError(t, err)
+
+		assert.Equal(t, models.ErrInvalidVerificationToken, service.VerifyEmail(ctx, token))
+	})
+}
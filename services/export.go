@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/repositories"
+	jsoniter "github.com/json-iterator/go"
+)
+
+var linkExportHeader = []string{
+	"id", "shortCode", "shortUrl", "title", "originalUrl", "domain", "createdAt", "updatedAt",
+	"activatesAt", "expiresAt", "fallbackUrl", "maxClicks", "clickCount", "passwordProtected",
+}
+
+var visitExportHeader = []string{"id", "shortCode", "visitedAt", "ip", "userAgent", "referrer", "destinationId", "country", "region", "city"}
+
+type ExportService interface {
+	ExportLinks(ctx context.Context, userID string, query models.LinkQuery, format models.ExportFormat, w io.Writer) error
+	ExportLinkVisits(ctx context.Context, userID string, domain string, shortCode string, query models.VisitExportQuery, format models.ExportFormat, w io.Writer) error
+}
+
+type exportService struct {
+	i   *di.Injector
+	lr  repositories.LinkRepository
+	lvr repositories.LinkVisitRepository
+}
+
+func NewExportService(i *di.Injector) (ExportService, error) {
+	linkRepository, err := di.Invoke[repositories.LinkRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.LinkRepository: %w", err)
+	}
+
+	linkVisitRepository, err := di.Invoke[repositories.LinkVisitRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.LinkVisitRepository: %w", err)
+	}
+
+	return &exportService{
+		i:   i,
+		lr:  linkRepository,
+		lvr: linkVisitRepository,
+	}, nil
+}
+
+func (e *exportService) ExportLinks(ctx context.Context, userID string, query models.LinkQuery, format models.ExportFormat, w io.Writer) error {
+	if !isValidExportRange(query.From, query.To) {
+		return models.ErrInvalidExportQuery
+	}
+
+	encoder, err := newExportEncoder(w, format, linkExportHeader)
+	if err != nil {
+		return err
+	}
+
+	err = e.lr.StreamLinksByUserID(ctx, userID, query, func(link *models.Link) error {
+		response := link.ToResponse(config.Env.APIURL)
+		return encoder.Encode(linkExportRecord(response), response)
+	})
+	if err != nil {
+		return fmt.Errorf("stream links: %w", err)
+	}
+
+	return encoder.Close()
+}
+
+func (e *exportService) ExportLinkVisits(ctx context.Context, userID string, domain string, shortCode string, query models.VisitExportQuery, format models.ExportFormat, w io.Writer) error {
+	if !isValidExportRange(query.From, query.To) {
+		return models.ErrInvalidExportQuery
+	}
+
+	encoder, err := newExportEncoder(w, format, visitExportHeader)
+	if err != nil {
+		return err
+	}
+
+	link, err := e.lr.GetLinkByShortCode(ctx, domain, shortCode)
+	if err != nil {
+		return fmt.Errorf("get link by short code: %w", err)
+	}
+
+	if link == nil {
+		return models.ErrLinkNotFound
+	}
+
+	if link.UserID != userID {
+		return models.ErrLinkNotBelongToUser
+	}
+
+	err = e.lvr.StreamVisits(ctx, link.ID, query, func(linkVisit *models.LinkVisit) error {
+		export := linkVisit.ToExport(link.ShortCode)
+		return encoder.Encode(visitExportRecord(export), export)
+	})
+	if err != nil {
+		return fmt.Errorf("stream visits: %w", err)
+	}
+
+	return encoder.Close()
+}
+
+type exportEncoder struct {
+	w      io.Writer
+	format models.ExportFormat
+	csv    *csv.Writer
+	header []string
+	rows   int
+}
+
+// isValidExportRange accepts open-ended ranges and From == To; only an inverted range is rejected.
+func isValidExportRange(from, to *time.Time) bool {
+	return from == nil || to == nil || !from.After(*to)
+}
+
+func newExportEncoder(w io.Writer, format models.ExportFormat, header []string) (*exportEncoder, error) {
+	switch format {
+	case models.ExportFormatCSV, models.ExportFormatJSON, models.ExportFormatNDJSON:
+	default:
+		return nil, models.ErrInvalidExportFormat
+	}
+
+	encoder := &exportEncoder{
+		w:      w,
+		format: format,
+		header: header,
+	}
+
+	if format == models.ExportFormatCSV {
+		encoder.csv = csv.NewWriter(w)
+	}
+
+	return encoder, nil
+}
+
+func (e *exportEncoder) Encode(record []string, value any) error {
+	defer func() { e.rows++ }()
+
+	switch e.format {
+	case models.ExportFormatCSV:
+		if e.rows == 0 {
+			if err := e.csv.Write(e.header); err != nil {
+				return fmt.Errorf("write csv header: %w", err)
+			}
+		}
+
+		for i := range record {
+			record[i] = sanitizeCSVField(record[i])
+		}
+
+		if err := e.csv.Write(record); err != nil {
+			return fmt.Errorf("write csv record: %w", err)
+		}
+
+		return nil
+	case models.ExportFormatJSON:
+		separator := ","
+		if e.rows == 0 {
+			separator = "["
+		}
+
+		if _, err := io.WriteString(e.w, separator); err != nil {
+			return fmt.Errorf("write json separator: %w", err)
+		}
+
+		return e.writeJSON(value)
+	default:
+		if err := e.writeJSON(value); err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(e.w, "\n"); err != nil {
+			return fmt.Errorf("write ndjson newline: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func (e *exportEncoder) Close() error {
+	switch e.format {
+	case models.ExportFormatCSV:
+		if e.rows == 0 {
+			if err := e.csv.Write(e.header); err != nil {
+				return fmt.Errorf("write csv header: %w", err)
+			}
+		}
+
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return fmt.Errorf("flush csv: %w", err)
+		}
+	case models.ExportFormatJSON:
+		closing := "]"
+		if e.rows == 0 {
+			closing = "[]"
+		}
+
+		if _, err := io.WriteString(e.w, closing); err != nil {
+			return fmt.Errorf("write json closing: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *exportEncoder) writeJSON(value any) error {
+	data, err := jsoniter.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshal record: %w", err)
+	}
+
+	if _, err := e.w.Write(data); err != nil {
+		return fmt.Errorf("write record: %w", err)
+	}
+
+	return nil
+}
+
+func linkExportRecord(response models.LinkResponse) []string {
+	var maxClicks string
+	if response.MaxClicks != nil {
+		maxClicks = strconv.FormatInt(*response.MaxClicks, 10)
+	}
+
+	return []string{
+		response.ID,
+		response.ShortCode,
+		response.ShortURL,
+		response.Title,
+		response.OriginalURL,
+		response.Domain,
+		response.CreatedAt,
+		response.UpdatedAt,
+		response.ActivatesAt,
+		response.ExpiresAt,
+		response.FallbackURL,
+		maxClicks,
+		strconv.FormatInt(response.ClickCount, 10),
+		strconv.FormatBool(response.PasswordProtected),
+	}
+}
+
+func visitExportRecord(export models.LinkVisitExport) []string {
+	return []string{
+		export.ID,
+		export.ShortCode,
+		export.VisitedAt,
+		export.IP,
+		export.UserAgent,
+		export.Referrer,
+		export.DestinationID,
+		export.Country,
+		export.Region,
+		export.City,
+	}
+}
+
+func sanitizeCSVField(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
@@ -0,0 +1,230 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportLinks(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	links := []models.Link{
+		{ID: "1", ShortCode: "first", OriginalURL: "https://example.com/a", Title: sql.NullString{String: "=SUM(A1)", Valid: true}, CreatedAt: createdAt, ClickCount: 3},
+		{ID: "2", ShortCode: "second", OriginalURL: "https://example.com/b", CreatedAt: createdAt},
+	}
+
+	streamLinks := func(mockLinkRepository *mocks.LinkRepositoryMock, userID string) {
+		mockLinkRepository.On("StreamLinksByUserID", mock.Anything, userID, models.LinkQuery{}, mock.Anything).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(3).(func(*models.Link) error)
+				for i := range links {
+					fn(&links[i])
+				}
+			}).
+			Return(nil)
+	}
+
+	t.Run("when the format is csv, it should write a header and escape formula cells", func(t *testing.T) {
+		mockLinkRepository := new(mocks.LinkRepositoryMock)
+		service := &exportService{lr: mockLinkRepository}
+
+		userID := uuid.New().String()
+		streamLinks(mockLinkRepository, userID)
+
+		var buffer bytes.Buffer
+		err := service.ExportLinks(context.Background(), userID, models.LinkQuery{}, models.ExportFormatCSV, &buffer)
+
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+		assert.Len(t, lines, 3)
+		assert.True(t, strings.HasPrefix(lines[0], "id,shortCode,shortUrl,title"))
+		assert.Contains(t, lines[1], ",'=SUM(A1),")
+		assert.Contains(t, lines[2], ",example.com - untitled,")
+	})
+
+	t.Run("when the format is json, it should write a single array", func(t *testing.T) {
+		mockLinkRepository := new(mocks.LinkRepositoryMock)
+		service := &exportService{lr: mockLinkRepository}
+
+		userID := uuid.New().String()
+		streamLinks(mockLinkRepository, userID)
+
+		var buffer bytes.Buffer
+		err := service.ExportLinks(context.Background(), userID, models.LinkQuery{}, models.ExportFormatJSON, &buffer)
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(buffer.String(), `[{"id":"1"`))
+		assert.Contains(t, buffer.String(), `},{"id":"2"`)
+		assert.True(t, strings.HasSuffix(buffer.String(), "}]"))
+	})
+
+	t.Run("when the format is ndjson, it should write one object per line", func(t *testing.T) {
+		mockLinkRepository := new(mocks.LinkRepositoryMock)
+		service := &exportService{lr: mockLinkRepository}
+
+		userID := uuid.New().String()
+		streamLinks(mockLinkRepository, userID)
+
+		var buffer bytes.Buffer
+		err := service.ExportLinks(context.Background(), userID, models.LinkQuery{}, models.ExportFormatNDJSON, &buffer)
+
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+		assert.Len(t, lines, 2)
+		assert.True(t, strings.HasPrefix(lines[1], `{"id":"2"`))
+	})
+
+	t.Run("when there are no links and the format is json, it should write an empty array", func(t *testing.T) {
+		mockLinkRepository := new(mocks.LinkRepositoryMock)
+		service := &exportService{lr: mockLinkRepository}
+
+		userID := uuid.New().String()
+		mockLinkRepository.On("StreamLinksByUserID", mock.Anything, userID, models.LinkQuery{}, mock.Anything).Return(nil)
+
+		var buffer bytes.Buffer
+		err := service.ExportLinks(context.Background(), userID, models.LinkQuery{}, models.ExportFormatJSON, &buffer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "[]", buffer.String())
+	})
+
+	t.Run("when from equals to, it should accept the range", func(t *testing.T) {
+		mockLinkRepository := new(mocks.LinkRepositoryMock)
+		service := &exportService{lr: mockLinkRepository}
+
+		userID := uuid.New().String()
+		instant := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+		query := models.LinkQuery{From: &instant, To: &instant}
+
+		mockLinkRepository.On("StreamLinksByUserID", mock.Anything, userID, query, mock.Anything).Return(nil)
+
+		err := service.ExportLinks(context.Background(), userID, query, models.ExportFormatNDJSON, &bytes.Buffer{})
+
+		assert.NoError(t, err)
+		mockLinkRepository.AssertExpectations(t)
+	})
+
+	t.Run("when the range is inverted, it should return ErrInvalidExportQuery", func(t *testing.T) {
+		service := &exportService{}
+
+		from := time.Now()
+		to := from.Add(-time.Hour)
+
+		err := service.ExportLinks(context.Background(), uuid.New().String(), models.LinkQuery{From: &from, To: &to}, models.ExportFormatCSV, &bytes.Buffer{})
+
+		assert.ErrorIs(t, err, models.ErrInvalidExportQuery)
+	})
+
+	t.Run("when the format is unknown, it should return ErrInvalidExportFormat without writing", func(t *testing.T) {
+		mockLinkRepository := new(mocks.LinkRepositoryMock)
+		service := &exportService{lr: mockLinkRepository}
+
+		var buffer bytes.Buffer
+		err := service.ExportLinks(context.Background(), uuid.New().String(), models.LinkQuery{}, "xlsx", &buffer)
+
+		assert.ErrorIs(t, err, models.ErrInvalidExportFormat)
+		assert.Zero(t, buffer.Len())
+		mockLinkRepository.AssertNotCalled(t, "StreamLinksByUserID")
+	})
+}
+
+func TestExportLinkVisits(t *testing.T) {
+	t.Run("when the link belongs to the user, it should stream its visits", func(t *testing.T) {
+		mockLinkRepository := new(mocks.LinkRepositoryMock)
+		mockLinkVisitRepository := new(mocks.LinkVisitRepositoryMock)
+		service := &exportService{lr: mockLinkRepository, lvr: mockLinkVisitRepository}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: userID}
+		visitedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		mockLinkRepository.On("GetLinkByShortCode", ctx, "", "abcd1234").Return(link, nil)
+		mockLinkVisitRepository.On("StreamVisits", ctx, link.ID, models.VisitExportQuery{}, mock.Anything).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(3).(func(*models.LinkVisit) error)
+				fn(&models.LinkVisit{ID: "v1", LinkID: link.ID, IP: "127.0.0.1", Agent: "curl/8.0", VisitedAt: visitedAt})
+				fn(&models.LinkVisit{
+					ID:        "v2",
+					LinkID:    link.ID,
+					IP:        "10.0.0.1",
+					Agent:     "Mozilla",
+					Referrer:  sql.NullString{String: "https://news.example", Valid: true},
+					Country:   sql.NullString{String: "BR", Valid: true},
+					Region:    sql.NullString{String: "Sao Paulo", Valid: true},
+					City:      sql.NullString{String: "Campinas", Valid: true},
+					VisitedAt: visitedAt,
+				})
+			}).
+			Return(nil)
+
+		var buffer bytes.Buffer
+		err := service.ExportLinkVisits(ctx, userID, "", "abcd1234", models.VisitExportQuery{}, models.ExportFormatCSV, &buffer)
+
+		assert.NoError(t, err)
+		assert.Equal(t,
+			"id,shortCode,visitedAt,ip,userAgent,referrer,destinationId,country,region,city\n"+
+				"v1,abcd1234,2025-01-02T03:04:05Z,127.0.0.1,curl/8.0,,,,,\n"+
+				"v2,abcd1234,2025-01-02T03:04:05Z,10.0.0.1,Mozilla,https://news.example,,BR,Sao Paulo,Campinas\n",
+			buffer.String(),
+		)
+	})
+
+	t.Run("when the link belongs to another user, it should return ErrLinkNotBelongToUser", func(t *testing.T) {
+		mockLinkRepository := new(mocks.LinkRepositoryMock)
+		mockLinkVisitRepository := new(mocks.LinkVisitRepositoryMock)
+		service := &exportService{lr: mockLinkRepository, lvr: mockLinkVisitRepository}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: uuid.New().String()}
+
+		mockLinkRepository.On("GetLinkByShortCode", ctx, "", "abcd1234").Return(link, nil)
+
+		var buffer bytes.Buffer
+		err := service.ExportLinkVisits(ctx, uuid.New().String(), "", "abcd1234", models.VisitExportQuery{}, models.ExportFormatNDJSON, &buffer)
+
+		assert.ErrorIs(t, err, models.ErrLinkNotBelongToUser)
+		assert.Zero(t, buffer.Len())
+		mockLinkVisitRepository.AssertNotCalled(t, "StreamVisits")
+	})
+
+	t.Run("when from equals to, it should accept the range", func(t *testing.T) {
+		mockLinkRepository := new(mocks.LinkRepositoryMock)
+		mockLinkVisitRepository := new(mocks.LinkVisitRepositoryMock)
+		service := &exportService{lr: mockLinkRepository, lvr: mockLinkVisitRepository}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: userID}
+		instant := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+		query := models.VisitExportQuery{From: &instant, To: &instant}
+
+		mockLinkRepository.On("GetLinkByShortCode", ctx, "", "abcd1234").Return(link, nil)
+		mockLinkVisitRepository.On("StreamVisits", ctx, link.ID, query, mock.Anything).Return(nil)
+
+		err := service.ExportLinkVisits(ctx, userID, "", "abcd1234", query, models.ExportFormatNDJSON, &bytes.Buffer{})
+
+		assert.NoError(t, err)
+		mockLinkVisitRepository.AssertExpectations(t)
+	})
+
+	t.Run("when the range is inverted, it should return ErrInvalidExportQuery", func(t *testing.T) {
+		service := &exportService{}
+
+		from := time.Now()
+		to := from.Add(-time.Hour)
+
+		err := service.ExportLinkVisits(context.Background(), uuid.New().String(), "", "abcd1234", models.VisitExportQuery{From: &from, To: &to}, models.ExportFormatCSV, &bytes.Buffer{})
+
+		assert.ErrorIs(t, err, models.ErrInvalidExportQuery)
+	})
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/repositories"
+	"github.com/google/uuid"
+)
+
+type FolderService interface {
+	CreateFolder(ctx context.Context, userID string, payload models.FolderPayload) (*models.FolderResponse, error)
+	GetFolders(ctx context.Context, userID string) ([]models.FolderResponse, error)
+	UpdateFolder(ctx context.Context, userID, folderID string, payload models.FolderPayload) (*models.FolderResponse, error)
+	DeleteFolder(ctx context.Context, userID, folderID string) error
+	GetUserFolder(ctx context.Context, userID, folderID string) (*models.Folder, error)
+}
+
+type folderService struct {
+	i  *di.Injector
+	fr repositories.FolderRepository
+}
+
+func NewFolderService(i *di.Injector) (FolderService, error) {
+	folderRepository, err := di.Invoke[repositories.FolderRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.FolderRepository: %w", err)
+	}
+
+	return &folderService{
+		i:  i,
+		fr: folderRepository,
+	}, nil
+}
+
+func (f *folderService) CreateFolder(ctx context.Context, userID string, payload models.FolderPayload) (*models.FolderResponse, error) {
+	name, ok := normalizeLabel(payload.Name, models.MaxFolderNameLength)
+	if !ok {
+		return nil, models.ErrInvalidFolderName
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("generate UUID: %w", err)
+	}
+
+	folder := models.Folder{
+		ID:        id.String(),
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := f.fr.CreateFolder(ctx, folder); err != nil {
+		if err == models.ErrFolderAlreadyExists {
+			return nil, err
+		}
+		return nil, fmt.Errorf("create folder: %w", err)
+	}
+
+	response := folder.ToResponse()
+	return &response, nil
+}
+
+func (f *folderService) GetFolders(ctx context.Context, userID string) ([]models.FolderResponse, error) {
+	folders, err := f.fr.GetFoldersByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get folders by user ID: %w", err)
+	}
+
+	folderResponses := make([]models.FolderResponse, len(folders))
+	for i, folder := range folders {
+		folderResponses[i] = folder.ToResponse()
+	}
+
+	return folderResponses, nil
+}
+
+func (f *folderService) UpdateFolder(ctx context.Context, userID, folderID string, payload models.FolderPayload) (*models.FolderResponse, error) {
+	name, ok := normalizeLabel(payload.Name, models.MaxFolderNameLength)
+	if !ok {
+		return nil, models.ErrInvalidFolderName
+	}
+
+	folder, err := f.GetUserFolder(ctx, userID, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	folder.Name = name
+	folder.UpdatedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+
+	if err := f.fr.UpdateFolder(ctx, *folder); err != nil {
+		if err == models.ErrFolderAlreadyExists {
+			return nil, err
+		}
+		return nil, fmt.Errorf("update folder: %w", err)
+	}
+
+	response := folder.ToResponse()
+	return &response, nil
+}
+
+func (f *folderService) DeleteFolder(ctx context.Context, userID, folderID string) error {
+	folder, err := f.GetUserFolder(ctx, userID, folderID)
+	if err != nil {
+		return err
+	}
+
+	if err := f.fr.DeleteFolder(ctx, folder.ID); err != nil {
+		return fmt.Errorf("delete folder: %w", err)
+	}
+
+	return nil
+}
+
+func (f *folderService) GetUserFolder(ctx context.Context, userID, folderID string) (*models.Folder, error) {
+	folder, err := f.fr.GetFolderByID(ctx, folderID)
+	if err != nil {
+		return nil, fmt.Errorf("get folder by ID: %w", err)
+	}
+
+	if folder == nil {
+		return nil, models.ErrFolderNotFound
+	}
+
+	if folder.UserID != userID {
+		return nil, models.ErrFolderNotBelongToUser
+	}
+
+	return folder, nil
+}
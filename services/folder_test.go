@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateFolder(t *testing.T) {
+	t.Run("when the folder belongs to the user, it should rename it and set UpdatedAt", func(t *testing.T) {
+		mockFolderRepository := new(mocks.FolderRepositoryMock)
+		service := &folderService{fr: mockFolderRepository}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		folderID := uuid.New().String()
+
+		mockFolderRepository.On("GetFolderByID", ctx, folderID).
+			Return(&models.Folder{ID: folderID, UserID: userID, Name: "old", LinkCount: 4}, nil)
+		mockFolderRepository.On("UpdateFolder", ctx, mock.MatchedBy(func(folder models.Folder) bool {
+			return folder.Name == "Launches" && folder.UpdatedAt.Valid
+		})).Return(nil)
+
+		response, err := service.UpdateFolder(ctx, userID, folderID, models.FolderPayload{Name: "Launches"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Launches", response.Name)
+		assert.Equal(t, 4, response.LinkCount)
+		assert.NotEmpty(t, response.UpdatedAt)
+		mockFolderRepository.AssertExpectations(t)
+	})
+
+	t.Run("when the name is too long, it should return ErrInvalidFolderName", func(t *testing.T) {
+		mockFolderRepository := new(mocks.FolderRepositoryMock)
+		service := &folderService{fr: mockFolderRepository}
+
+		_, err := service.UpdateFolder(context.Background(), uuid.New().String(), uuid.New().String(), models.FolderPayload{
+			Name: strings.Repeat("a", models.MaxFolderNameLength+1),
+		})
+
+		assert.Equal(t, models.ErrInvalidFolderName, err)
+		mockFolderRepository.AssertNotCalled(t, "GetFolderByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the folder belongs to another user, it should return ErrFolderNotBelongToUser", func(t *testing.T) {
+		mockFolderRepository := new(mocks.FolderRepositoryMock)
+		service := &folderService{fr: mockFolderRepository}
+
+		ctx := context.Background()
+		folderID := uuid.New().String()
+
+		mockFolderRepository.On("GetFolderByID", ctx, folderID).
+			Return(&models.Folder{ID: folderID, UserID: uuid.New().String()}, nil)
+
+		_, err := service.UpdateFolder(ctx, uuid.New().String(), folderID, models.FolderPayload{Name: "Launches"})
+
+		assert.Equal(t, models.ErrFolderNotBelongToUser, err)
+		mockFolderRepository.AssertNotCalled(t, "UpdateFolder", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/oschwald/maxminddb-golang"
+)
+
+const geoIPLanguage = "en"
+
+type GeoIPLocator interface {
+	Lookup(ip string) models.GeoLocation
+	Close() error
+}
+
+type geoIPRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	Subdivisions []struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"subdivisions"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+}
+
+type geoIPDatabase struct {
+	reader  *maxminddb.Reader
+	modTime time.Time
+	size    int64
+}
+
+type maxMindGeoIPLocator struct {
+	cfg      models.GeoIP
+	database atomic.Pointer[geoIPDatabase]
+	stop     chan struct{}
+	once     sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewGeoIPLocator(i *di.Injector) (GeoIPLocator, error) {
+	return newGeoIPLocator(config.Env.GeoIP), nil
+}
+
+func newGeoIPLocator(cfg models.GeoIP) *maxMindGeoIPLocator {
+	locator := &maxMindGeoIPLocator{
+		cfg:  cfg,
+		stop: make(chan struct{}),
+	}
+
+	if cfg.DatabasePath == "" {
+		return locator
+	}
+
+	if err := locator.reload(); err != nil {
+		slog.Warn("load geoip database", "error", err, "path", cfg.DatabasePath)
+	}
+
+	if cfg.ReloadInterval > 0 {
+		locator.wg.Add(1)
+		go locator.watch()
+	}
+
+	return locator
+}
+
+func (g *maxMindGeoIPLocator) Lookup(ip string) models.GeoLocation {
+	database := g.database.Load()
+	if database == nil {
+		return models.GeoLocation{}
+	}
+
+	address := parseVisitorIP(ip)
+	if address == nil {
+		return models.GeoLocation{}
+	}
+
+	var record geoIPRecord
+	if err := database.reader.Lookup(address, &record); err != nil {
+		return models.GeoLocation{}
+	}
+
+	location := models.GeoLocation{
+		Country: strings.ToUpper(record.Country.ISOCode),
+		City:    record.City.Names[geoIPLanguage],
+	}
+
+	if len(record.Subdivisions) > 0 {
+		location.Region = record.Subdivisions[0].Names[geoIPLanguage]
+	}
+
+	return location
+}
+
+func (g *maxMindGeoIPLocator) Close() error {
+	g.once.Do(func() {
+		close(g.stop)
+	})
+	g.wg.Wait()
+
+	return nil
+}
+
+func (g *maxMindGeoIPLocator) watch() {
+	defer g.wg.Done()
+
+	ticker := time.NewTicker(g.cfg.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-g.stop:
+			return
+		case <-ticker.C:
+			if err := g.reload(); err != nil {
+				slog.Warn("reload geoip database", "error", err, "path", g.cfg.DatabasePath)
+			}
+		}
+	}
+}
+
+func (g *maxMindGeoIPLocator) reload() error {
+	info, err := os.Stat(g.cfg.DatabasePath)
+	if err != nil {
+		return fmt.Errorf("stat database: %w", err)
+	}
+
+	current := g.database.Load()
+	if current != nil && current.modTime.Equal(info.ModTime()) && current.size == info.Size() {
+		return nil
+	}
+
+	data, err := os.ReadFile(g.cfg.DatabasePath)
+	if err != nil {
+		return fmt.Errorf("read database: %w", err)
+	}
+
+	reader, err := maxminddb.FromBytes(data)
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+
+	g.database.Store(&geoIPDatabase{
+		reader:  reader,
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	})
+
+	slog.Info("geoip database loaded", "path", g.cfg.DatabasePath, "type", reader.Metadata.DatabaseType, "built_at", time.Unix(int64(reader.Metadata.BuildEpoch), 0).UTC())
+
+	return nil
+}
+
+// parseVisitorIP accepts a single address that the handler already resolved
+// through the trusted-proxy rule. A raw forwarding chain is rejected rather
+// than trusting whichever hop the client put first.
+func parseVisitorIP(value string) net.IP {
+	value = strings.TrimSpace(value)
+
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+
+	return net.ParseIP(strings.Trim(value, "[]"))
+}
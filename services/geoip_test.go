@@ -0,0 +1,45 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoIPLocator(t *testing.T) {
+	t.Run("when no database is configured, it should return an empty location", func(t *testing.T) {
+		locator := newGeoIPLocator(models.GeoIP{})
+		defer locator.Close()
+
+		assert.Equal(t, models.GeoLocation{}, locator.Lookup("8.8.8.8"))
+	})
+
+	t.Run("when the database file is invalid, it should keep returning an empty location", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "GeoLite2-City.mmdb")
+		assert.NoError(t, os.WriteFile(path, []byte("not a maxmind database"), 0o600))
+
+		locator := newGeoIPLocator(models.GeoIP{DatabasePath: path})
+		defer locator.Close()
+
+		assert.Error(t, locator.reload())
+		assert.Equal(t, models.GeoLocation{}, locator.Lookup("8.8.8.8"))
+	})
+}
+
+func TestParseVisitorIP(t *testing.T) {
+	t.Run("when the address is an unresolved proxy chain, it should return nil", func(t *testing.T) {
+		assert.Nil(t, parseVisitorIP("203.0.113.7, 10.0.0.1"))
+	})
+
+	t.Run("when the address has a port, it should strip it", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.7", parseVisitorIP("203.0.113.7:51234").String())
+		assert.Equal(t, "2001:db8::1", parseVisitorIP("[2001:db8::1]:443").String())
+	})
+
+	t.Run("when the address is invalid, it should return nil", func(t *testing.T) {
+		assert.Nil(t, parseVisitorIP("unknown"))
+	})
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	pkgecdsa "github.com/g-villarinho/link-fizz-api/pkgs/ecdsa"
+)
+
+const p256CoordinateLength = 32
+
+type KeyRing interface {
+	SigningKey() (string, *ecdsa.PrivateKey)
+	VerificationKey(kid string) (*ecdsa.PublicKey, error)
+	VerificationKeys() []*ecdsa.PublicKey
+	JWKS() models.JWKS
+}
+
+type keyRingEntry struct {
+	id        string
+	publicKey *ecdsa.PublicKey
+}
+
+type keyRing struct {
+	primaryID  string
+	primaryKey *ecdsa.PrivateKey
+	entries    []keyRingEntry
+	byID       map[string]*ecdsa.PublicKey
+	jwks       models.JWKS
+}
+
+func NewKeyRing(i *di.Injector) (KeyRing, error) {
+	ecdsaKeyPair, err := di.Invoke[pkgecdsa.EcdsaKeyPair](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke ecdsa.EcdsaKeyPair: %w", err)
+	}
+
+	return newKeyRing(ecdsaKeyPair, config.Env.KeyRing)
+}
+
+func newKeyRing(kp pkgecdsa.EcdsaKeyPair, cfg models.KeyRing) (*keyRing, error) {
+	ring := &keyRing{
+		byID: make(map[string]*ecdsa.PublicKey, len(cfg.Keys)),
+		jwks: models.JWKS{Keys: make([]models.JWK, 0, len(cfg.Keys))},
+	}
+
+	privateKeys := make(map[string]*ecdsa.PrivateKey, len(cfg.Keys))
+	firstSigningID := ""
+
+	for _, key := range cfg.Keys {
+		var privateKey *ecdsa.PrivateKey
+		var publicKey *ecdsa.PublicKey
+		var err error
+
+		if key.PrivateKey != "" {
+			privateKey, err = kp.ParseECDSAPrivateKey(key.PrivateKey)
+			if err != nil {
+				return nil, fmt.Errorf("parse private key %s: %w", key.ID, err)
+			}
+			publicKey = &privateKey.PublicKey
+		}
+
+		if key.PublicKey != "" {
+			parsed, err := kp.ParseECDSAPublicKey(key.PublicKey)
+			if err != nil {
+				return nil, fmt.Errorf("parse public key %s: %w", key.ID, err)
+			}
+
+			if publicKey != nil && !publicKey.Equal(parsed) {
+				return nil, fmt.Errorf("key %s: public key does not match private key", key.ID)
+			}
+			publicKey = parsed
+		}
+
+		if publicKey == nil {
+			return nil, fmt.Errorf("key %s: no key material", key.ID)
+		}
+
+		if publicKey.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("key %s: only P-256 keys are supported", key.ID)
+		}
+
+		jwk := newJWK(key.ID, publicKey)
+		if _, exists := ring.byID[jwk.Kid]; exists {
+			return nil, fmt.Errorf("duplicate key id %s", jwk.Kid)
+		}
+
+		ring.byID[jwk.Kid] = publicKey
+		ring.entries = append(ring.entries, keyRingEntry{id: jwk.Kid, publicKey: publicKey})
+		ring.jwks.Keys = append(ring.jwks.Keys, jwk)
+
+		if privateKey != nil {
+			privateKeys[jwk.Kid] = privateKey
+			if firstSigningID == "" {
+				firstSigningID = jwk.Kid
+			}
+		}
+	}
+
+	ring.primaryID = cfg.PrimaryKeyID
+	if ring.primaryID == "" {
+		ring.primaryID = firstSigningID
+	}
+
+	ring.primaryKey = privateKeys[ring.primaryID]
+	if ring.primaryKey == nil {
+		return nil, fmt.Errorf("primary signing key %q not found in key ring", ring.primaryID)
+	}
+
+	return ring, nil
+}
+
+func (k *keyRing) SigningKey() (string, *ecdsa.PrivateKey) {
+	return k.primaryID, k.primaryKey
+}
+
+func (k *keyRing) VerificationKey(kid string) (*ecdsa.PublicKey, error) {
+	publicKey, found := k.byID[kid]
+	if !found {
+		return nil, models.ErrUnknownSigningKey
+	}
+
+	return publicKey, nil
+}
+
+func (k *keyRing) VerificationKeys() []*ecdsa.PublicKey {
+	keys := make([]*ecdsa.PublicKey, 0, len(k.entries))
+	for _, entry := range k.entries {
+		keys = append(keys, entry.publicKey)
+	}
+
+	return keys
+}
+
+func (k *keyRing) JWKS() models.JWKS {
+	return k.jwks
+}
+
+func newJWK(kid string, publicKey *ecdsa.PublicKey) models.JWK {
+	x := base64.RawURLEncoding.EncodeToString(publicKey.X.FillBytes(make([]byte, p256CoordinateLength)))
+	y := base64.RawURLEncoding.EncodeToString(publicKey.Y.FillBytes(make([]byte, p256CoordinateLength)))
+
+	if kid == "" {
+		// RFC 7638 thumbprint: the required members in lexicographic order, no whitespace.
+		thumbprint := sha256.Sum256([]byte(`{"crv":"P-256","kty":"EC","x":"` + x + `","y":"` + y + `"}`))
+		kid = base64.RawURLEncoding.EncodeToString(thumbprint[:])
+	}
+
+	return models.JWK{
+		Kty: "EC",
+		Crv: "P-256",
+		Use: "sig",
+		Alg: "ES256",
+		Kid: kid,
+		X:   x,
+		Y:   y,
+	}
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	pkgecdsa "github.com/g-villarinho/link-fizz-api/pkgs/ecdsa"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTestKey(t *testing.T, id string) models.Key {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	der, err := x509.MarshalECPrivateKey(privateKey)
+	assert.NoError(t, err)
+
+	return models.Key{
+		ID:         id,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})),
+	}
+}
+
+func publicOnly(t *testing.T, key models.Key) models.Key {
+	t.Helper()
+
+	kp, _ := pkgecdsa.NewEcdsaKeyPair(nil)
+	privateKey, err := kp.ParseECDSAPrivateKey(key.PrivateKey)
+	assert.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	assert.NoError(t, err)
+
+	return models.Key{
+		ID:        key.ID,
+		PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}
+}
+
+func newTestTokenService(t *testing.T, cfg models.KeyRing) *tokenService {
+	t.Helper()
+
+	kp, _ := pkgecdsa.NewEcdsaKeyPair(nil)
+	ring, err := newKeyRing(kp, cfg)
+	assert.NoError(t, err)
+
+	return &tokenService{kr: ring}
+}
+
+func TestNewKeyRing(t *testing.T) {
+	kp, _ := pkgecdsa.NewEcdsaKeyPair(nil)
+
+	t.Run("when no primary key is configured, it should sign with the first private key", func(t *testing.T) {
+		oldKey := generateTestKey(t, "2024-01")
+		newKey := generateTestKey(t, "2025-01")
+
+		ring, err := newKeyRing(kp, models.KeyRing{Keys: []models.Key{publicOnly(t, oldKey), newKey}})
+
+		assert.NoError(t, err)
+		kid, _ := ring.SigningKey()
+		assert.Equal(t, "2025-01", kid)
+	})
+
+	t.Run("when the primary key has no private key, it should return an error", func(t *testing.T) {
+		key := generateTestKey(t, "2024-01")
+
+		_, err := newKeyRing(kp, models.KeyRing{PrimaryKeyID: "2024-01", Keys: []models.Key{publicOnly(t, key)}})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("when two keys share an id, it should return an error", func(t *testing.T) {
+		_, err := newKeyRing(kp, models.KeyRing{Keys: []models.Key{generateTestKey(t, "a"), generateTestKey(t, "a")}})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("when a key has no id, it should use its thumbprint", func(t *testing.T) {
+		ring, err := newKeyRing(kp, models.KeyRing{Keys: []models.Key{generateTestKey(t, "")}})
+
+		assert.NoError(t, err)
+		kid, _ := ring.SigningKey()
+		assert.Len(t, kid, 43)
+		assert.Equal(t, kid, ring.JWKS().Keys[0].Kid)
+	})
+
+	t.Run("when the ring has several keys, it should publish all of them in the JWKS", func(t *testing.T) {
+		ring, err := newKeyRing(kp, models.KeyRing{Keys: []models.Key{generateTestKey(t, "a"), publicOnly(t, generateTestKey(t, "b"))}})
+
+		assert.NoError(t, err)
+		jwks := ring.JWKS()
+		assert.Len(t, jwks.Keys, 2)
+		assert.Equal(t, "a", jwks.Keys[0].Kid)
+		assert.Equal(t, "b", jwks.Keys[1].Kid)
+		assert.Equal(t, "ES256", jwks.Keys[1].Alg)
+		assert.Len(t, jwks.Keys[1].X, 43)
+		assert.Len(t, jwks.Keys[1].Y, 43)
+	})
+}
+
+func TestTokenServiceKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	oldKey := generateTestKey(t, "2024-01")
+	newKey := generateTestKey(t, "2025-01")
+
+	t.Run("when a token is generated, it should carry the primary key id and validate", func(t *testing.T) {
+		service := newTestTokenService(t, models.KeyRing{PrimaryKeyID: "2025-01", Keys: []models.Key{oldKey, newKey}})
+
+		token, err := service.GenerateToken(ctx, "user-id", "session-id", now, now.Add(time.Minute))
+		assert.NoError(t, err)
+
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+		assert.NoError(t, err)
+		assert.Equal(t, "2025-01", parsed.Header["kid"])
+
+		claims, err := service.ValidateToken(ctx, token)
+		assert.NoError(t, err)
+		assert.Equal(t, "session-id", claims.Sid)
+	})
+
+	t.Run("when the primary key rotates, it should still accept tokens signed with the previous key", func(t *testing.T) {
+		before := newTestTokenService(t, models.KeyRing{Keys: []models.Key{oldKey}})
+		after := newTestTokenService(t, models.KeyRing{PrimaryKeyID: "2025-01", Keys: []models.Key{newKey, publicOnly(t, oldKey)}})
+
+		token, err := before.GenerateLinkAccessToken(ctx, "link-id", now, now.Add(time.Minute))
+		assert.NoError(t, err)
+
+		assert.NoError(t, after.ValidateLinkAccessToken(ctx, token, "link-id"))
+	})
+
+	t.Run("when the token has no kid, it should try every key in the ring", func(t *testing.T) {
+		service := newTestTokenService(t, models.KeyRing{Keys: []models.Key{newKey, oldKey}})
+
+		kp, _ := pkgecdsa.NewEcdsaKeyPair(nil)
+		privateKey, err := kp.ParseECDSAPrivateKey(oldKey.PrivateKey)
+		assert.NoError(t, err)
+
+		token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+			"sub": "user-id",
+			"sid": "session-id",
+			"exp": now.Add(time.Minute).Unix(),
+		}).SignedString(privateKey)
+		assert.NoError(t, err)
+
+		_, err = service.ValidateToken(ctx, token)
+		assert.NoError(t, err)
+	})
+
+	t.Run("when the kid is not in the ring, it should reject the token", func(t *testing.T) {
+		retired := newTestTokenService(t, models.KeyRing{Keys: []models.Key{oldKey}})
+		service := newTestTokenService(t, models.KeyRing{Keys: []models.Key{newKey}})
+
+		token, err := retired.GenerateToken(ctx, "user-id", "session-id", now, now.Add(time.Minute))
+		assert.NoError(t, err)
+
+		_, err = service.ValidateToken(ctx, token)
+		assert.ErrorIs(t, err, models.ErrUnknownSigningKey)
+	})
+}
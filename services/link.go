@@ -3,7 +3,14 @@ package services
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
@@ -12,29 +19,86 @@ import (
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
 	"github.com/g-villarinho/link-fizz-api/repositories"
 	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
 )
 
-const shortCodeLength = 8
+const shortCodeInsertAttempts = 3
+
+var customCodePattern = regexp.MustCompile(`^[a-z0-9_-]{1,20}$`)
+
+var linkCSVColumns = map[string]string{
+	"destination":     "destination",
+	"destination_url": "destination",
+	"destinationurl":  "destination",
+	"url":             "destination",
+	"title":           "title",
+	"custom_code":     "customCode",
+	"customcode":      "customCode",
+	"code":            "customCode",
+}
+
+var linkValidationErrors = []error{
+	models.ErrInvalidLinkURL,
+	models.ErrInvalidExpiration,
+	models.ErrInvalidMaxClicks,
+	models.ErrInvalidActivationWindow,
+	models.ErrInvalidShortCode,
+	models.ErrReservedShortCode,
+	models.ErrInvalidDomain,
+	models.ErrDomainNotFound,
+	models.ErrDomainNotBelongToUser,
+	models.ErrDomainNotVerified,
+	models.ErrFolderNotFound,
+	models.ErrFolderNotBelongToUser,
+	models.ErrTagNotFound,
+	models.ErrTagNotBelongToUser,
+	models.ErrInvalidRedirectType,
+	models.ErrInvalidLinkDestinations,
+	models.ErrInvalidDestinationWeight,
+	models.ErrInvalidDestinationLabel,
+}
+
+type batchLink struct {
+	index      int
+	link       *models.Link
+	customCode string
+}
 
 type LinkService interface {
-	CreateLink(ctx context.Context, userID, destinationURL string, title, customCode *string) (*models.CreateLinkResponse, error)
-	GetOriginalURLByShortCode(ctx context.Context, shortCode string) (string, error)
+	CreateLink(ctx context.Context, userID string, payload models.LinkPayload) (*models.CreateLinkResponse, error)
+	CreateLinks(ctx context.Context, userID string, payloads []models.LinkPayload) (*models.BatchLinkResponse, error)
+	ImportLinks(ctx context.Context, userID string, reader io.Reader) (*models.BatchLinkResponse, error)
+	GetOriginalURLByShortCode(ctx context.Context, domain, shortCode string) (string, error)
 	GetUsersShortURLs(ctx context.Context, userID string) ([]string, error)
-	GetLinkByShortCode(ctx context.Context, shortCode string) (*models.Link, error)
-	GetLinksByUserID(ctx context.Context, userID string) ([]models.LinkResponse, error)
-	GetLinkDetails(ctx context.Context, userID string, shortCode string) (*models.LinkResponse, error)
+	GetLinkByShortCode(ctx context.Context, domain, shortCode string) (*models.Link, error)
+	GetLinksByUserID(ctx context.Context, userID string, query models.LinkQuery) (*models.LinkPage, error)
+	GetLinkDetails(ctx context.Context, userID, domain, shortCode string) (*models.LinkResponse, error)
+	UpdateLink(ctx context.Context, userID, domain, shortCode string, payload models.UpdateLinkPayload) (*models.LinkResponse, error)
+	DeleteLink(ctx context.Context, userID, domain, shortCode string) error
 }
 
 type linkService struct {
 	i  *di.Injector
-	us UtilsService
+	sg ShortCodeGenerator
+	ss SecurityService
 	lr repositories.LinkRepository
+	ur repositories.UserRepository
+	lc LinkCache
+	rc ReservedCodeRegistry
+	ds DomainService
+	ts TagService
+	fs FolderService
 }
 
 func NewLinkService(i *di.Injector) (LinkService, error) {
-	utilsService, err := di.Invoke[UtilsService](i)
+	shortCodeGenerator, err := di.Invoke[ShortCodeGenerator](i)
 	if err != nil {
-		return nil, fmt.Errorf("invoke services.UtilsService: %w", err)
+		return nil, fmt.Errorf("invoke services.ShortCodeGenerator: %w", err)
+	}
+
+	securityService, err := di.Invoke[SecurityService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.SecurityService: %w", err)
 	}
 
 	linkRepository, err := di.Invoke[repositories.LinkRepository](i)
@@ -42,21 +106,67 @@ func NewLinkService(i *di.Injector) (LinkService, error) {
 		return nil, fmt.Errorf("invoke repositories.LinkRepository: %w", err)
 	}
 
+	userRepository, err := di.Invoke[repositories.UserRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.UserRepository: %w", err)
+	}
+
+	linkCache, err := di.Invoke[LinkCache](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.LinkCache: %w", err)
+	}
+
+	reservedCodeRegistry, err := di.Invoke[ReservedCodeRegistry](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.ReservedCodeRegistry: %w", err)
+	}
+
+	domainService, err := di.Invoke[DomainService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.DomainService: %w", err)
+	}
+
+	tagService, err := di.Invoke[TagService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.TagService: %w", err)
+	}
+
+	folderService, err := di.Invoke[FolderService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.FolderService: %w", err)
+	}
+
 	return &linkService{
 		i:  i,
-		us: utilsService,
+		sg: shortCodeGenerator,
+		ss: securityService,
 		lr: linkRepository,
+		ur: userRepository,
+		lc: linkCache,
+		rc: reservedCodeRegistry,
+		ds: domainService,
+		ts: tagService,
+		fs: folderService,
 	}, nil
 }
 
-func (l *linkService) CreateLink(ctx context.Context, userID, destinationURL string, title, customCode *string) (*models.CreateLinkResponse, error) {
-	var shortCode string
+func (l *linkService) CreateLink(ctx context.Context, userID string, payload models.LinkPayload) (*models.CreateLinkResponse, error) {
+	if err := l.ensureCanCreateLinks(ctx, userID); err != nil {
+		return nil, err
+	}
 
-	if customCode != nil && *customCode != "" {
-		cleanCode := strings.ReplaceAll(*customCode, " ", "")
-		cleanCode = strings.ToLower(cleanCode)
+	defaultRedirectType, err := l.defaultRedirectType(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	link, customCode, err := l.buildLink(ctx, userID, payload, defaultRedirectType)
+	if err != nil {
+		return nil, err
+	}
 
-		linkFromCode, err := l.lr.GetLinkByShortCode(ctx, cleanCode)
+	if customCode != "" {
+		linkFromCode, err := l.lr.GetLinkByShortCode(ctx, link.DomainKey(), customCode)
 		if err != nil {
 			return nil, fmt.Errorf("get link by short code: %w", err)
 		}
@@ -64,42 +174,358 @@ func (l *linkService) CreateLink(ctx context.Context, userID, destinationURL str
 		if linkFromCode != nil {
 			return nil, models.ErrCustomCodeAlreadyExists
 		}
+	}
 
-		shortCode = cleanCode
-	} else {
-		generatedCode, err := l.us.GenerateShortCode(shortCodeLength)
+	if err := l.insertLink(ctx, link, customCode); err != nil {
+		return nil, err
+	}
+
+	return &models.CreateLinkResponse{
+		ShortCode:    link.ShortCode,
+		RedirectType: link.RedirectType,
+		Warnings:     models.RedirectWarnings(link.RedirectType),
+	}, nil
+}
+
+func (l *linkService) CreateLinks(ctx context.Context, userID string, payloads []models.LinkPayload) (*models.BatchLinkResponse, error) {
+	if err := l.ensureCanCreateLinks(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if len(payloads) == 0 {
+		return nil, models.ErrEmptyLinkBatch
+	}
+
+	if len(payloads) > config.Env.LinkBatchMaxItems {
+		return nil, models.ErrLinkBatchTooLarge
+	}
+
+	defaultRedirectType, err := l.defaultRedirectType(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.BatchLinkResult, len(payloads))
+	pending := make([]batchLink, 0, len(payloads))
+	customCodes := make(map[models.LinkAddress]struct{})
+
+	for i, payload := range payloads {
+		results[i].Index = i
+
+		link, customCode, err := l.buildLink(ctx, userID, payload, defaultRedirectType)
+		if err != nil {
+			if !isLinkValidationError(err) {
+				return nil, err
+			}
+			results[i].Error = err.Error()
+			continue
+		}
+
+		if customCode != "" {
+			address := models.LinkAddress{Domain: link.DomainKey(), ShortCode: customCode}
+			if _, duplicated := customCodes[address]; duplicated {
+				results[i].Error = models.ErrCustomCodeAlreadyExists.Error()
+				continue
+			}
+			customCodes[address] = struct{}{}
+		}
+
+		pending = append(pending, batchLink{index: i, link: link, customCode: customCode})
+	}
+
+	codesByDomain := make(map[string][]string)
+	for address := range customCodes {
+		codesByDomain[address.Domain] = append(codesByDomain[address.Domain], address.ShortCode)
+	}
+
+	taken := make(map[models.LinkAddress]struct{})
+	for domain, shortCodes := range codesByDomain {
+		existing, err := l.lr.GetExistingShortCodes(ctx, domain, shortCodes)
+		if err != nil {
+			return nil, fmt.Errorf("get existing short codes: %w", err)
+		}
+
+		for _, shortCode := range existing {
+			taken[models.LinkAddress{Domain: domain, ShortCode: shortCode}] = struct{}{}
+		}
+	}
+
+	links := make([]models.Link, 0, len(pending))
+	accepted := pending[:0]
+
+	for _, item := range pending {
+		if item.customCode != "" {
+			if _, exists := taken[models.LinkAddress{Domain: item.link.DomainKey(), ShortCode: item.customCode}]; exists {
+				results[item.index].Error = models.ErrCustomCodeAlreadyExists.Error()
+				continue
+			}
+			item.link.ShortCode = item.customCode
+		} else {
+			shortCode, err := l.generateBatchCode(ctx, item.link.DomainKey(), customCodes)
+			if err != nil {
+				return nil, err
+			}
+			item.link.ShortCode = shortCode
+		}
+
+		links = append(links, *item.link)
+		accepted = append(accepted, item)
+	}
+
+	err = l.lr.CreateLinks(ctx, links)
+	if err != nil && !errors.Is(err, models.ErrShortCodeTaken) {
+		return nil, fmt.Errorf("create links: %w", err)
+	}
+
+	for _, item := range accepted {
+		if err != nil {
+			if insertErr := l.insertLink(ctx, item.link, item.customCode); insertErr != nil {
+				results[item.index].Error = insertErr.Error()
+				continue
+			}
+		} else {
+			l.lc.Invalidate(item.link.DomainKey(), item.link.ShortCode)
+		}
+
+		results[item.index].ShortCode = item.link.ShortCode
+		results[item.index].ShortURL = fmt.Sprintf("%s/%s", item.link.BaseURL(config.Env.APIURL), item.link.ShortCode)
+	}
+
+	response := &models.BatchLinkResponse{Results: results}
+	for _, result := range results {
+		if result.Error != "" {
+			response.Failed++
+		} else {
+			response.Created++
+		}
+	}
+
+	return response, nil
+}
+
+func (l *linkService) ImportLinks(ctx context.Context, userID string, reader io.Reader) (*models.BatchLinkResponse, error) {
+	if err := l.ensureCanCreateLinks(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, models.ErrInvalidLinkCSV
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		if column, ok := linkCSVColumns[strings.ToLower(strings.TrimSpace(name))]; ok {
+			columns[column] = i
+		}
+	}
+
+	if _, ok := columns["destination"]; !ok {
+		return nil, models.ErrInvalidLinkCSV
+	}
+
+	var payloads []models.LinkPayload
+	var lines []int
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
 		if err != nil {
-			return nil, fmt.Errorf("generate short code: %w", err)
+			return nil, models.ErrInvalidLinkCSV
+		}
+
+		if len(payloads) == config.Env.LinkBatchMaxItems {
+			return nil, models.ErrLinkBatchTooLarge
 		}
 
-		shortCode = generatedCode
+		line, _ := csvReader.FieldPos(0)
+		lines = append(lines, line)
+		payloads = append(payloads, models.LinkPayload{
+			DestinationURL: csvField(record, columns, "destination"),
+			Title:          toOptionalString(csvField(record, columns, "title")),
+			CustomCode:     toOptionalString(csvField(record, columns, "customCode")),
+		})
+	}
+
+	response, err := l.CreateLinks(ctx, userID, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range response.Results {
+		response.Results[i].Line = lines[response.Results[i].Index]
+	}
+
+	return response, nil
+}
+
+func (l *linkService) buildLink(ctx context.Context, userID string, payload models.LinkPayload, defaultRedirectType int) (*models.Link, string, error) {
+	if _, err := url.ParseRequestURI(payload.DestinationURL); err != nil {
+		return nil, "", models.ErrInvalidLinkURL
+	}
+
+	if payload.FallbackURL != nil && *payload.FallbackURL != "" {
+		if _, err := url.ParseRequestURI(*payload.FallbackURL); err != nil {
+			return nil, "", models.ErrInvalidLinkURL
+		}
+	}
+
+	now := time.Now().UTC()
+	if payload.ExpiresAt != nil && !payload.ExpiresAt.After(now) {
+		return nil, "", models.ErrInvalidExpiration
+	}
+
+	if payload.MaxClicks != nil && *payload.MaxClicks <= 0 {
+		return nil, "", models.ErrInvalidMaxClicks
+	}
+
+	if payload.ActivatesAt != nil && payload.ExpiresAt != nil && !payload.ActivatesAt.Before(*payload.ExpiresAt) {
+		return nil, "", models.ErrInvalidActivationWindow
+	}
+
+	redirectType := defaultRedirectType
+	if payload.RedirectType != nil {
+		if !models.IsValidRedirectType(*payload.RedirectType) {
+			return nil, "", models.ErrInvalidRedirectType
+		}
+		redirectType = *payload.RedirectType
+	}
+
+	destinations, err := buildLinkDestinations(payload.Destinations, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var customCode string
+
+	if payload.CustomCode != nil && *payload.CustomCode != "" {
+		cleanCode := strings.ReplaceAll(*payload.CustomCode, " ", "")
+		cleanCode = strings.ToLower(cleanCode)
+
+		if !customCodePattern.MatchString(cleanCode) {
+			return nil, "", models.ErrInvalidShortCode
+		}
+
+		if l.rc.IsReserved(cleanCode) {
+			return nil, "", models.ErrReservedShortCode
+		}
+
+		customCode = cleanCode
 	}
 
 	id, err := uuid.NewRandom()
 	if err != nil {
-		return nil, fmt.Errorf("generate UUID: %w", err)
+		return nil, "", fmt.Errorf("generate UUID: %w", err)
 	}
 
-	link := models.Link{
-		ID:          id.String(),
-		Title:       sql.NullString{String: *title, Valid: title != nil},
-		OriginalURL: destinationURL,
-		ShortCode:   shortCode,
-		UserID:      userID,
-		CreatedAt:   time.Now().UTC(),
+	link := &models.Link{
+		ID:           id.String(),
+		Title:        toNullString(payload.Title),
+		OriginalURL:  payload.DestinationURL,
+		UserID:       userID,
+		CreatedAt:    now,
+		ActivatesAt:  toNullTime(payload.ActivatesAt),
+		ExpiresAt:    toNullTime(payload.ExpiresAt),
+		FallbackURL:  toNullString(payload.FallbackURL),
+		MaxClicks:    toNullInt64(payload.MaxClicks),
+		RedirectType: redirectType,
+		Destinations: destinations,
 	}
 
-	if err := l.lr.CreateLink(ctx, link); err != nil {
-		return nil, fmt.Errorf("create link: %w", err)
+	if payload.StickyDestinations != nil {
+		link.StickyDestinations = *payload.StickyDestinations
 	}
 
-	return &models.CreateLinkResponse{
-		ShortCode: shortCode,
-	}, nil
+	passwordHash, err := l.hashLinkPassword(ctx, payload.Password)
+	if err != nil {
+		return nil, "", err
+	}
+	link.PasswordHash = passwordHash
+
+	if payload.Domain != nil && *payload.Domain != "" {
+		domain, err := l.ds.GetVerifiedDomain(ctx, userID, *payload.Domain)
+		if err != nil {
+			return nil, "", err
+		}
+		link.Domain = sql.NullString{String: domain.Hostname, Valid: true}
+	}
+
+	if payload.FolderID != nil && *payload.FolderID != "" {
+		folder, err := l.fs.GetUserFolder(ctx, userID, *payload.FolderID)
+		if err != nil {
+			return nil, "", err
+		}
+		link.FolderID = sql.NullString{String: folder.ID, Valid: true}
+	}
+
+	if len(payload.TagIDs) > 0 {
+		tags, err := l.ts.GetUserTags(ctx, userID, payload.TagIDs)
+		if err != nil {
+			return nil, "", err
+		}
+		link.Tags = tags
+	}
+
+	return link, customCode, nil
+}
+
+func (l *linkService) generateBatchCode(ctx context.Context, domain string, used map[models.LinkAddress]struct{}) (string, error) {
+	for range shortCodeInsertAttempts {
+		shortCode, err := l.sg.Generate(ctx, domain)
+		if err != nil {
+			return "", fmt.Errorf("generate short code: %w", err)
+		}
+
+		address := models.LinkAddress{Domain: domain, ShortCode: shortCode}
+		if _, exists := used[address]; !exists {
+			used[address] = struct{}{}
+			return shortCode, nil
+		}
+	}
+
+	return "", models.ErrShortCodeExhausted
+}
+
+func (l *linkService) insertLink(ctx context.Context, link *models.Link, customCode string) error {
+	for attempt := 1; ; attempt++ {
+		link.ShortCode = customCode
+
+		if customCode == "" {
+			shortCode, err := l.sg.Generate(ctx, link.DomainKey())
+			if err != nil {
+				return fmt.Errorf("generate short code: %w", err)
+			}
+			link.ShortCode = shortCode
+		}
+
+		err := l.lr.CreateLink(ctx, *link)
+		if err == nil {
+			l.lc.Invalidate(link.DomainKey(), link.ShortCode)
+			return nil
+		}
+
+		if !errors.Is(err, models.ErrShortCodeTaken) {
+			return fmt.Errorf("create link: %w", err)
+		}
+
+		if customCode != "" {
+			return models.ErrCustomCodeAlreadyExists
+		}
+
+		if attempt >= shortCodeInsertAttempts {
+			return models.ErrShortCodeExhausted
+		}
+	}
 }
 
-func (l *linkService) GetOriginalURLByShortCode(ctx context.Context, shortCode string) (string, error) {
-	originalUrl, err := l.lr.GetOriginalURLByShortCode(ctx, shortCode)
+func (l *linkService) GetOriginalURLByShortCode(ctx context.Context, domain string, shortCode string) (string, error) {
+	originalUrl, err := l.lr.GetOriginalURLByShortCode(ctx, domain, shortCode)
 	if err != nil {
 		return "", fmt.Errorf("get original URL by short code: %w", err)
 	}
@@ -112,27 +538,31 @@ func (l *linkService) GetOriginalURLByShortCode(ctx context.Context, shortCode s
 }
 
 func (l *linkService) GetUsersShortURLs(ctx context.Context, userID string) ([]string, error) {
-	shortCodes, err := l.lr.GetAllShortCodesByUserID(ctx, userID)
+	addresses, err := l.lr.GetLinkAddressesByUserID(ctx, userID)
 	if err != nil {
-		return nil, fmt.Errorf("get all short codes: %w", err)
+		return nil, fmt.Errorf("get link addresses: %w", err)
 	}
 
-	if len(shortCodes) == 0 {
+	if len(addresses) == 0 {
 		return []string{}, nil
 	}
 
-	shortUrls := make([]string, len(shortCodes))
+	shortUrls := make([]string, len(addresses))
 	apiURL := config.Env.APIURL
 
-	for i, shortCode := range shortCodes {
-		shortUrls[i] = fmt.Sprintf("%s/%s", apiURL, shortCode)
+	for i, address := range addresses {
+		link := models.Link{
+			ShortCode: address.ShortCode,
+			Domain:    sql.NullString{String: address.Domain, Valid: address.Domain != ""},
+		}
+		shortUrls[i] = fmt.Sprintf("%s/%s", link.BaseURL(apiURL), link.ShortCode)
 	}
 
 	return shortUrls, nil
 }
 
-func (l *linkService) GetLinkByShortCode(ctx context.Context, shortCode string) (*models.Link, error) {
-	link, err := l.lr.GetLinkByShortCode(ctx, shortCode)
+func (l *linkService) GetLinkByShortCode(ctx context.Context, domain string, shortCode string) (*models.Link, error) {
+	link, err := l.lr.GetLinkByShortCode(ctx, domain, shortCode)
 	if err != nil {
 		return nil, fmt.Errorf("get link by short code: %w", err)
 	}
@@ -141,31 +571,216 @@ func (l *linkService) GetLinkByShortCode(ctx context.Context, shortCode string)
 		return nil, models.ErrLinkNotFound
 	}
 
+	if err := resolveLinkRules(link.Rules); err != nil {
+		logger := slog.With(
+			"service", "link",
+			"method", "GetLinkByShortCode",
+		)
+		logger.Error("resolve link rules", "linkId", link.ID, "error", err)
+	}
+
 	return link, nil
 }
 
-func (l *linkService) GetLinksByUserID(ctx context.Context, userID string) ([]models.LinkResponse, error) {
-	links, err := l.lr.GetLinksByUserID(ctx, userID)
+func (l *linkService) GetLinksByUserID(ctx context.Context, userID string, query models.LinkQuery) (*models.LinkPage, error) {
+	query, err := normalizeLinkQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	pageQuery := query
+	pageQuery.Limit = query.Limit + 1
+
+	links, err := l.lr.GetLinksByUserID(ctx, userID, pageQuery)
 	if err != nil {
 		return nil, fmt.Errorf("get links by user ID: %w", err)
 	}
 
-	if len(links) == 0 {
-		return []models.LinkResponse{}, nil
+	total, err := l.lr.CountLinksByUserID(ctx, userID, query)
+	if err != nil {
+		return nil, fmt.Errorf("count links by user ID: %w", err)
+	}
+
+	page := &models.LinkPage{
+		Items: []models.LinkResponse{},
+		Total: total,
+	}
+
+	if len(links) > query.Limit {
+		links = links[:query.Limit]
+
+		nextCursor, err := encodeLinkCursor(links[len(links)-1].CursorFor(query.Sort))
+		if err != nil {
+			return nil, fmt.Errorf("encode cursor: %w", err)
+		}
+		page.NextCursor = nextCursor
+	}
+
+	if err := l.attachTags(ctx, links); err != nil {
+		return nil, err
+	}
+
+	if err := l.attachDestinations(ctx, links); err != nil {
+		return nil, err
 	}
 
 	apiURL := config.Env.APIURL
-	linkResponses := make([]models.LinkResponse, len(links))
+	for _, link := range links {
+		page.Items = append(page.Items, link.ToResponse(apiURL))
+	}
 
-	for i, link := range links {
-		linkResponses[i] = link.ToResponse(apiURL)
+	return page, nil
+}
+
+func (l *linkService) GetLinkDetails(ctx context.Context, userID string, domain string, shortCode string) (*models.LinkResponse, error) {
+	link, err := l.getUserLink(ctx, userID, domain, shortCode)
+	if err != nil {
+		return nil, err
+	}
+
+	links := []models.Link{*link}
+	if err := l.attachTags(ctx, links); err != nil {
+		return nil, err
+	}
+
+	response := links[0].ToResponse(config.Env.APIURL)
+	return &response, nil
+}
+
+func (l *linkService) UpdateLink(ctx context.Context, userID, domain, shortCode string, payload models.UpdateLinkPayload) (*models.LinkResponse, error) {
+	if (payload.ClearActivatesAt && payload.ActivatesAt != nil) || (payload.ClearExpiresAt && payload.ExpiresAt != nil) {
+		return nil, models.ErrConflictingSchedule
+	}
+
+	if payload.ClearMaxClicks && payload.MaxClicks != nil {
+		return nil, models.ErrConflictingMaxClicks
+	}
+
+	now := time.Now().UTC()
+	if payload.ExpiresAt != nil && !payload.ExpiresAt.After(now) {
+		return nil, models.ErrInvalidExpiration
+	}
+
+	if payload.MaxClicks != nil && *payload.MaxClicks <= 0 {
+		return nil, models.ErrInvalidMaxClicks
+	}
+
+	if payload.RedirectType != nil && !models.IsValidRedirectType(*payload.RedirectType) {
+		return nil, models.ErrInvalidRedirectType
+	}
+
+	link, err := l.getUserLink(ctx, userID, domain, shortCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Title != nil {
+		link.Title = toNullString(payload.Title)
+	}
+
+	if payload.DestinationURL != nil {
+		link.OriginalURL = *payload.DestinationURL
+	}
+
+	if payload.ActivatesAt != nil || payload.ClearActivatesAt {
+		link.ActivatesAt = toNullTime(payload.ActivatesAt)
+	}
+
+	if payload.ExpiresAt != nil || payload.ClearExpiresAt {
+		link.ExpiresAt = toNullTime(payload.ExpiresAt)
+	}
+
+	if link.ActivatesAt.Valid && link.ExpiresAt.Valid && !link.ActivatesAt.Time.Before(link.ExpiresAt.Time) {
+		return nil, models.ErrInvalidActivationWindow
+	}
+
+	if payload.FallbackURL != nil {
+		link.FallbackURL = toNullString(payload.FallbackURL)
+	}
+
+	if payload.MaxClicks != nil || payload.ClearMaxClicks {
+		link.MaxClicks = toNullInt64(payload.MaxClicks)
+	}
+
+	if payload.RedirectType != nil {
+		link.RedirectType = *payload.RedirectType
+	}
+
+	if payload.Destinations != nil {
+		destinations, err := buildLinkDestinations(*payload.Destinations, link.Destinations)
+		if err != nil {
+			return nil, err
+		}
+		link.Destinations = destinations
+	}
+
+	if payload.StickyDestinations != nil {
+		link.StickyDestinations = *payload.StickyDestinations
+	}
+
+	if payload.Password != nil {
+		passwordHash, err := l.hashLinkPassword(ctx, payload.Password)
+		if err != nil {
+			return nil, err
+		}
+		link.PasswordHash = passwordHash
+	}
+
+	if payload.FolderID != nil {
+		link.FolderID = sql.NullString{}
+
+		if *payload.FolderID != "" {
+			folder, err := l.fs.GetUserFolder(ctx, userID, *payload.FolderID)
+			if err != nil {
+				return nil, err
+			}
+			link.FolderID = sql.NullString{String: folder.ID, Valid: true}
+		}
 	}
 
-	return linkResponses, nil
+	if payload.TagIDs != nil {
+		tags, err := l.ts.GetUserTags(ctx, userID, *payload.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+		link.Tags = tags
+	} else {
+		tagsByLink, err := l.ts.GetLinkTags(ctx, []string{link.ID})
+		if err != nil {
+			return nil, fmt.Errorf("get link tags: %w", err)
+		}
+		link.Tags = tagsByLink[link.ID]
+	}
+
+	link.UpdatedAt = sql.NullTime{Time: now, Valid: true}
+
+	if err := l.lr.UpdateLink(ctx, *link); err != nil {
+		return nil, fmt.Errorf("update link: %w", err)
+	}
+
+	l.lc.Invalidate(link.DomainKey(), link.ShortCode)
+
+	response := link.ToResponse(config.Env.APIURL)
+	return &response, nil
 }
 
-func (l *linkService) GetLinkDetails(ctx context.Context, userID string, shortCode string) (*models.LinkResponse, error) {
-	link, err := l.lr.GetLinkByShortCode(ctx, shortCode)
+func (l *linkService) DeleteLink(ctx context.Context, userID, domain, shortCode string) error {
+	link, err := l.getUserLink(ctx, userID, domain, shortCode)
+	if err != nil {
+		return err
+	}
+
+	if err := l.lr.DeleteLink(ctx, link.ID); err != nil {
+		return fmt.Errorf("delete link: %w", err)
+	}
+
+	l.lc.Invalidate(link.DomainKey(), link.ShortCode)
+
+	return nil
+}
+
+func (l *linkService) getUserLink(ctx context.Context, userID, domain, shortCode string) (*models.Link, error) {
+	link, err := l.lr.GetLinkByShortCode(ctx, domain, shortCode)
 	if err != nil {
 		return nil, fmt.Errorf("get link by short code: %w", err)
 	}
@@ -178,6 +793,269 @@ func (l *linkService) GetLinkDetails(ctx context.Context, userID string, shortCo
 		return nil, models.ErrLinkNotBelongToUser
 	}
 
-	response := link.ToResponse(config.Env.APIURL)
-	return &response, nil
+	return link, nil
+}
+
+func (l *linkService) ensureCanCreateLinks(ctx context.Context, userID string) error {
+	if !config.Env.EmailVerification.RequiredForLinks {
+		return nil
+	}
+
+	user, err := l.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get user by ID: %w", err)
+	}
+
+	if user == nil || !user.EmailVerifiedAt.Valid {
+		return models.ErrEmailNotVerified
+	}
+
+	return nil
+}
+
+func (l *linkService) defaultRedirectType(ctx context.Context, userID string) (int, error) {
+	user, err := l.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("get user by ID: %w", err)
+	}
+
+	if user == nil || !models.IsValidRedirectType(user.DefaultRedirectType) {
+		return models.DefaultRedirectType, nil
+	}
+
+	return user.DefaultRedirectType, nil
+}
+
+func (l *linkService) attachTags(ctx context.Context, links []models.Link) error {
+	if len(links) == 0 {
+		return nil
+	}
+
+	linkIDs := make([]string, len(links))
+	for i, link := range links {
+		linkIDs[i] = link.ID
+	}
+
+	tagsByLink, err := l.ts.GetLinkTags(ctx, linkIDs)
+	if err != nil {
+		return fmt.Errorf("get link tags: %w", err)
+	}
+
+	for i := range links {
+		links[i].Tags = tagsByLink[links[i].ID]
+	}
+
+	return nil
+}
+
+func (l *linkService) attachDestinations(ctx context.Context, links []models.Link) error {
+	if len(links) == 0 {
+		return nil
+	}
+
+	linkIDs := make([]string, len(links))
+	for i, link := range links {
+		linkIDs[i] = link.ID
+	}
+
+	destinationsByLink, err := l.lr.GetDestinationsByLinkIDs(ctx, linkIDs)
+	if err != nil {
+		return fmt.Errorf("get link destinations: %w", err)
+	}
+
+	for i := range links {
+		links[i].Destinations = destinationsByLink[links[i].ID]
+	}
+
+	return nil
+}
+
+func (l *linkService) hashLinkPassword(ctx context.Context, password *string) (sql.NullString, error) {
+	if password == nil || *password == "" {
+		return sql.NullString{}, nil
+	}
+
+	passwordHash, err := l.ss.HashPassword(ctx, *password)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("hash password: %w", err)
+	}
+
+	return sql.NullString{String: passwordHash, Valid: true}, nil
+}
+
+func buildLinkDestinations(payloads []models.LinkDestinationPayload, current []models.LinkDestination) ([]models.LinkDestination, error) {
+	if len(payloads) == 0 {
+		return nil, nil
+	}
+
+	if len(payloads) < models.MinLinkDestinations || len(payloads) > models.MaxLinkDestinations {
+		return nil, models.ErrInvalidLinkDestinations
+	}
+
+	currentIDs := make(map[string]string, len(current))
+	for _, destination := range current {
+		currentIDs[destination.URL] = destination.ID
+	}
+
+	destinations := make([]models.LinkDestination, 0, len(payloads))
+	for position, payload := range payloads {
+		if _, err := url.ParseRequestURI(payload.URL); err != nil {
+			return nil, models.ErrInvalidLinkURL
+		}
+
+		if payload.Weight < 1 || payload.Weight > models.MaxDestinationWeight {
+			return nil, models.ErrInvalidDestinationWeight
+		}
+
+		destination := models.LinkDestination{
+			URL:      payload.URL,
+			Weight:   payload.Weight,
+			Position: position,
+		}
+
+		if payload.Label != nil && strings.TrimSpace(*payload.Label) != "" {
+			label, ok := normalizeLabel(*payload.Label, models.MaxDestinationLabelLength)
+			if !ok {
+				return nil, models.ErrInvalidDestinationLabel
+			}
+			destination.Label = sql.NullString{String: label, Valid: true}
+		}
+
+		if id, ok := currentIDs[payload.URL]; ok {
+			destination.ID = id
+			delete(currentIDs, payload.URL)
+		} else {
+			id, err := uuid.NewRandom()
+			if err != nil {
+				return nil, fmt.Errorf("generate UUID: %w", err)
+			}
+			destination.ID = id.String()
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return destinations, nil
+}
+
+func normalizeLinkQuery(query models.LinkQuery) (models.LinkQuery, error) {
+	switch {
+	case query.Limit < 0:
+		return query, models.ErrInvalidLinkQuery
+	case query.Limit == 0:
+		query.Limit = models.DefaultLinkPageSize
+	case query.Limit > models.MaxLinkPageSize:
+		query.Limit = models.MaxLinkPageSize
+	}
+
+	switch query.Sort {
+	case "":
+		query.Sort = models.LinkSortCreated
+	case models.LinkSortCreated, models.LinkSortUpdated, models.LinkSortClicks:
+	default:
+		return query, models.ErrInvalidLinkQuery
+	}
+
+	switch query.Order {
+	case "":
+		query.Order = models.SortDesc
+	case models.SortAsc, models.SortDesc:
+	default:
+		return query, models.ErrInvalidLinkQuery
+	}
+
+	if query.From != nil && query.To != nil && query.From.After(*query.To) {
+		return query, models.ErrInvalidLinkQuery
+	}
+
+	query.Domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(query.Domain)), "www.")
+	query.Search = strings.TrimSpace(query.Search)
+
+	if query.Cursor != "" {
+		cursor, err := decodeLinkCursor(query.Cursor)
+		if err != nil || cursor.Sort != query.Sort {
+			return query, models.ErrInvalidLinkCursor
+		}
+		query.After = cursor
+	}
+
+	return query, nil
+}
+
+func encodeLinkCursor(cursor models.LinkCursor) (string, error) {
+	data, err := jsoniter.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeLinkCursor(value string) (*models.LinkCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor models.LinkCursor
+	if err := jsoniter.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+
+	if cursor.ID == "" {
+		return nil, models.ErrInvalidLinkCursor
+	}
+
+	return &cursor, nil
+}
+
+func isLinkValidationError(err error) bool {
+	for _, validationErr := range linkValidationErrors {
+		if errors.Is(err, validationErr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func csvField(record []string, columns map[string]int, column string) string {
+	index, ok := columns[column]
+	if !ok || index >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[index])
+}
+
+func toOptionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func toNullString(value *string) sql.NullString {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *value, Valid: true}
+}
+
+func toNullTime(value *time.Time) sql.NullTime {
+	if value == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: value.UTC(), Valid: true}
+}
+
+func toNullInt64(value *int64) sql.NullInt64 {
+	if value == nil {
+		return sql.NullInt64{}
+	}
+
+	return sql.NullInt64{Int64: *value, Valid: true}
 }
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setLinkBatchMaxItems(t *testing.T, maxItems int) {
+	previous := config.Env.LinkBatchMaxItems
+	config.Env.LinkBatchMaxItems = maxItems
+	t.Cleanup(func() { config.Env.LinkBatchMaxItems = previous })
+}
+
+func TestCreateLinks(t *testing.T) {
+	t.Run("when some rows are invalid, it should insert the valid ones and report per-row errors", func(t *testing.T) {
+		setLinkBatchMaxItems(t, 10)
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			sg: mockGenerator,
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			lc: newMemoryLinkCache(models.LinkCache{}),
+			rc: newReservedCodeRegistry(),
+		}
+
+		ctx := context.Background()
+		promo, taken, duplicated := "promo", "taken", "promo"
+
+		mockRepo.On("GetExistingShortCodes", ctx, "", mock.MatchedBy(func(codes []string) bool {
+			return len(codes) == 2
+		})).Return([]string{"taken"}, nil)
+		mockGenerator.On("Generate", ctx, "").Return("gen12345", nil)
+		mockRepo.On("CreateLinks", ctx, mock.MatchedBy(func(links []models.Link) bool {
+			return len(links) == 2 && links[0].ShortCode == "promo" && links[1].ShortCode == "gen12345"
+		})).Return(nil)
+
+		response, err := service.CreateLinks(ctx, uuid.New().String(), []models.LinkPayload{
+			{DestinationURL: "https://example.com/a", CustomCode: &promo},
+			{DestinationURL: "not a url"},
+			{DestinationURL: "https://example.com/c", CustomCode: &taken},
+			{DestinationURL: "https://example.com/d", CustomCode: &duplicated},
+			{DestinationURL: "https://example.com/e"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, response.Created)
+		assert.Equal(t, 3, response.Failed)
+		assert.Equal(t, "promo", response.Results[0].ShortCode)
+		assert.Equal(t, models.ErrInvalidLinkURL.Error(), response.Results[1].Error)
+		assert.Equal(t, models.ErrCustomCodeAlreadyExists.Error(), response.Results[2].Error)
+		assert.Equal(t, models.ErrCustomCodeAlreadyExists.Error(), response.Results[3].Error)
+		assert.Equal(t, "gen12345", response.Results[4].ShortCode)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the bulk insert hits a duplicate, it should fall back to row by row inserts", func(t *testing.T) {
+		setLinkBatchMaxItems(t, 10)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			lc: newMemoryLinkCache(models.LinkCache{}),
+			rc: newReservedCodeRegistry(),
+		}
+
+		ctx := context.Background()
+		first, second := "first", "second"
+
+		mockRepo.On("GetExistingShortCodes", ctx, "", mock.Anything).Return([]string{}, nil)
+		mockRepo.On("CreateLinks", ctx, mock.Anything).Return(models.ErrShortCodeTaken)
+		mockRepo.On("CreateLink", ctx, mock.MatchedBy(func(l models.Link) bool { return l.ShortCode == "first" })).Return(models.ErrShortCodeTaken)
+		mockRepo.On("CreateLink", ctx, mock.MatchedBy(func(l models.Link) bool { return l.ShortCode == "second" })).Return(nil)
+
+		response, err := service.CreateLinks(ctx, uuid.New().String(), []models.LinkPayload{
+			{DestinationURL: "https://example.com/a", CustomCode: &first},
+			{DestinationURL: "https://example.com/b", CustomCode: &second},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, response.Created)
+		assert.Equal(t, models.ErrCustomCodeAlreadyExists.Error(), response.Results[0].Error)
+		assert.Equal(t, "second", response.Results[1].ShortCode)
+	})
+
+	t.Run("when the batch exceeds the limit, it should return ErrLinkBatchTooLarge", func(t *testing.T) {
+		setLinkBatchMaxItems(t, 1)
+		service := &linkService{}
+
+		_, err := service.CreateLinks(context.Background(), uuid.New().String(), make([]models.LinkPayload, 2))
+
+		assert.Equal(t, models.ErrLinkBatchTooLarge, err)
+	})
+}
+
+func TestImportLinks(t *testing.T) {
+	t.Run("when the CSV is valid, it should create links and report line numbers", func(t *testing.T) {
+		setLinkBatchMaxItems(t, 10)
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			sg: mockGenerator,
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			lc: newMemoryLinkCache(models.LinkCache{}),
+			rc: newReservedCodeRegistry(),
+		}
+
+		ctx := context.Background()
+		csv := "Title,Destination,Custom_Code\n" +
+			"Launch,https://example.com/launch,launch\n" +
+			"Broken,,\n" +
+			"\"Docs, v2\",https://example.com/docs,\n"
+
+		mockRepo.On("GetExistingShortCodes", ctx, "", []string{"launch"}).Return([]string{}, nil)
+		mockGenerator.On("Generate", ctx, "").Return("gen12345", nil)
+		mockRepo.On("CreateLinks", ctx, mock.MatchedBy(func(links []models.Link) bool {
+			return len(links) == 2 && links[1].Title.String == "Docs, v2"
+		})).Return(nil)
+
+		response, err := service.ImportLinks(ctx, uuid.New().String(), strings.NewReader(csv))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, response.Created)
+		assert.Equal(t, 1, response.Failed)
+		assert.Equal(t, 2, response.Results[0].Line)
+		assert.Equal(t, "launch", response.Results[0].ShortCode)
+		assert.Equal(t, 3, response.Results[1].Line)
+		assert.Equal(t, models.ErrInvalidLinkURL.Error(), response.Results[1].Error)
+		assert.Equal(t, "gen12345", response.Results[2].ShortCode)
+	})
+
+	t.Run("when the destination column is missing, it should return ErrInvalidLinkCSV", func(t *testing.T) {
+		setLinkBatchMaxItems(t, 10)
+		service := &linkService{}
+
+		_, err := service.ImportLinks(context.Background(), uuid.New().String(), strings.NewReader("title,code\nfoo,bar\n"))
+
+		assert.Equal(t, models.ErrInvalidLinkCSV, err)
+	})
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+type LinkCache interface {
+	Get(domain, shortCode string) (link *models.Link, hit bool)
+	Set(domain, shortCode string, link *models.Link)
+	SetNotFound(domain, shortCode string)
+	Invalidate(domain, shortCode string)
+}
+
+type linkCacheEntry struct {
+	address   models.LinkAddress
+	link      *models.Link
+	expiresAt time.Time
+}
+
+type memoryLinkCache struct {
+	mu      sync.Mutex
+	cfg     models.LinkCache
+	entries map[models.LinkAddress]*list.Element
+	order   *list.List
+	now     func() time.Time
+}
+
+func NewLinkCache(i *di.Injector) (LinkCache, error) {
+	return newMemoryLinkCache(config.Env.LinkCache), nil
+}
+
+func newMemoryLinkCache(cfg models.LinkCache) *memoryLinkCache {
+	if cfg.Size <= 0 {
+		cfg.Size = 1
+	}
+
+	return &memoryLinkCache{
+		cfg:     cfg,
+		entries: make(map[models.LinkAddress]*list.Element),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+func (c *memoryLinkCache) Get(domain string, shortCode string) (*models.Link, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[models.LinkAddress{Domain: domain, ShortCode: shortCode}]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*linkCacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	if entry.link == nil {
+		return nil, true
+	}
+
+	link := *entry.link
+	return &link, true
+}
+
+func (c *memoryLinkCache) Set(domain string, shortCode string, link *models.Link) {
+	if link == nil || c.cfg.TTL <= 0 {
+		return
+	}
+
+	cached := *link
+	c.put(models.LinkAddress{Domain: domain, ShortCode: shortCode}, &cached, c.cfg.TTL)
+}
+
+func (c *memoryLinkCache) SetNotFound(domain string, shortCode string) {
+	if c.cfg.NegativeTTL <= 0 {
+		return
+	}
+
+	c.put(models.LinkAddress{Domain: domain, ShortCode: shortCode}, nil, c.cfg.NegativeTTL)
+}
+
+func (c *memoryLinkCache) Invalidate(domain string, shortCode string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[models.LinkAddress{Domain: domain, ShortCode: shortCode}]; ok {
+		c.remove(element)
+	}
+}
+
+func (c *memoryLinkCache) put(address models.LinkAddress, link *models.Link, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+
+	if element, ok := c.entries[address]; ok {
+		entry := element.Value.(*linkCacheEntry)
+		entry.link = link
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[address] = c.order.PushFront(&linkCacheEntry{
+		address:   address,
+		link:      link,
+		expiresAt: expiresAt,
+	})
+
+	for c.order.Len() > c.cfg.Size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *memoryLinkCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*linkCacheEntry).address)
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryLinkCache(t *testing.T) {
+	t.Run("when the entry is stored, it should return a copy of the link", func(t *testing.T) {
+		cache := newMemoryLinkCache(models.LinkCache{Size: 10, TTL: time.Minute})
+		link := &models.Link{ID: "1", ShortCode: "abcd1234"}
+
+		cache.Set("", link.ShortCode, link)
+		cached, hit := cache.Get("", link.ShortCode)
+
+		assert.True(t, hit)
+		assert.Equal(t, link, cached)
+		assert.NotSame(t, link, cached)
+	})
+
+	t.Run("when the code is unknown, it should cache the miss", func(t *testing.T) {
+		cache := newMemoryLinkCache(models.LinkCache{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+
+		cache.SetNotFound("", "missing")
+		cached, hit := cache.Get("", "missing")
+
+		assert.True(t, hit)
+		assert.Nil(t, cached)
+	})
+
+	t.Run("when the TTL elapses, it should miss", func(t *testing.T) {
+		now := time.Now()
+		cache := newMemoryLinkCache(models.LinkCache{Size: 10, TTL: time.Minute})
+		cache.now = func() time.Time { return now }
+
+		cache.Set("", "abcd1234", &models.Link{ID: "1"})
+		now = now.Add(time.Minute)
+		_, hit := cache.Get("", "abcd1234")
+
+		assert.False(t, hit)
+		assert.Equal(t, 0, cache.order.Len())
+	})
+
+	t.Run("when the size is exceeded, it should evict the least recently used entry", func(t *testing.T) {
+		cache := newMemoryLinkCache(models.LinkCache{Size: 2, TTL: time.Minute})
+
+		cache.Set("", "a", &models.Link{ID: "a"})
+		cache.Set("", "b", &models.Link{ID: "b"})
+		cache.Get("", "a")
+		cache.Set("", "c", &models.Link{ID: "c"})
+
+		_, hitA := cache.Get("", "a")
+		_, hitB := cache.Get("", "b")
+		_, hitC := cache.Get("", "c")
+
+		assert.True(t, hitA)
+		assert.False(t, hitB)
+		assert.True(t, hitC)
+	})
+
+	t.Run("when the entry is invalidated, it should miss", func(t *testing.T) {
+		cache := newMemoryLinkCache(models.LinkCache{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+
+		cache.SetNotFound("", "abcd1234")
+		cache.Invalidate("", "abcd1234")
+		_, hit := cache.Get("", "abcd1234")
+
+		assert.False(t, hit)
+	})
+}
@@ -0,0 +1,548 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/repositories"
+	"github.com/google/uuid"
+)
+
+var languageTagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+var ruleDevices = []string{models.DeviceDesktop, models.DeviceMobile, models.DeviceTablet, models.DeviceBot}
+
+var ruleOperatingSystems = []string{
+	models.OSWindows, models.OSMacOS, models.OSLinux, models.OSIOS, models.OSAndroid, models.OSChromeOS, models.OSOther,
+}
+
+type LinkRuleService interface {
+	GetLinkRules(ctx context.Context, userID, domain, shortCode string) ([]models.LinkRuleResponse, error)
+	CreateLinkRule(ctx context.Context, userID, domain, shortCode string, payload models.LinkRulePayload) (*models.LinkRuleResponse, error)
+	UpdateLinkRule(ctx context.Context, userID, domain, shortCode, ruleID string, payload models.LinkRulePayload) (*models.LinkRuleResponse, error)
+	DeleteLinkRule(ctx context.Context, userID, domain, shortCode, ruleID string) error
+}
+
+type linkRuleService struct {
+	i   *di.Injector
+	lr  repositories.LinkRepository
+	lrr repositories.LinkRuleRepository
+	lc  LinkCache
+}
+
+func NewLinkRuleService(i *di.Injector) (LinkRuleService, error) {
+	linkRepository, err := di.Invoke[repositories.LinkRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.LinkRepository: %w", err)
+	}
+
+	linkRuleRepository, err := di.Invoke[repositories.LinkRuleRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.LinkRuleRepository: %w", err)
+	}
+
+	linkCache, err := di.Invoke[LinkCache](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.LinkCache: %w", err)
+	}
+
+	return &linkRuleService{
+		i:   i,
+		lr:  linkRepository,
+		lrr: linkRuleRepository,
+		lc:  linkCache,
+	}, nil
+}
+
+func (l *linkRuleService) GetLinkRules(ctx context.Context, userID, domain, shortCode string) ([]models.LinkRuleResponse, error) {
+	link, err := l.getUserLink(ctx, userID, domain, shortCode)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := l.lrr.GetRulesByLinkID(ctx, link.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get rules by link ID: %w", err)
+	}
+
+	responses := make([]models.LinkRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = rule.ToResponse()
+	}
+
+	return responses, nil
+}
+
+func (l *linkRuleService) CreateLinkRule(ctx context.Context, userID, domain, shortCode string, payload models.LinkRulePayload) (*models.LinkRuleResponse, error) {
+	conditions, err := normalizeRuleConditions(payload.DestinationURL, payload.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := l.getUserLink(ctx, userID, domain, shortCode)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := l.lrr.GetRulesByLinkID(ctx, link.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get rules by link ID: %w", err)
+	}
+
+	if len(rules) >= models.MaxLinkRules {
+		return nil, models.ErrLinkRuleLimitReached
+	}
+
+	position := len(rules)
+	if payload.Position != nil {
+		if *payload.Position < 0 || *payload.Position > len(rules) {
+			return nil, models.ErrInvalidRulePosition
+		}
+		position = *payload.Position
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("generate UUID: %w", err)
+	}
+
+	rule := models.LinkRule{
+		ID:             id.String(),
+		LinkID:         link.ID,
+		DestinationURL: payload.DestinationURL,
+		Conditions:     conditions,
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	rules = slices.Insert(rules, position, rule)
+
+	if err := l.saveRules(ctx, link, rules); err != nil {
+		return nil, err
+	}
+
+	response := rules[position].ToResponse()
+	return &response, nil
+}
+
+func (l *linkRuleService) UpdateLinkRule(ctx context.Context, userID, domain, shortCode, ruleID string, payload models.LinkRulePayload) (*models.LinkRuleResponse, error) {
+	conditions, err := normalizeRuleConditions(payload.DestinationURL, payload.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := l.getUserLink(ctx, userID, domain, shortCode)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := l.lrr.GetRulesByLinkID(ctx, link.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get rules by link ID: %w", err)
+	}
+
+	index := slices.IndexFunc(rules, func(rule models.LinkRule) bool { return rule.ID == ruleID })
+	if index < 0 {
+		return nil, models.ErrLinkRuleNotFound
+	}
+
+	position := index
+	if payload.Position != nil {
+		if *payload.Position < 0 || *payload.Position >= len(rules) {
+			return nil, models.ErrInvalidRulePosition
+		}
+		position = *payload.Position
+	}
+
+	rule := rules[index]
+	rule.DestinationURL = payload.DestinationURL
+	rule.Conditions = conditions
+	rule.UpdatedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+
+	rules = slices.Delete(rules, index, index+1)
+	rules = slices.Insert(rules, position, rule)
+
+	if err := l.saveRules(ctx, link, rules); err != nil {
+		return nil, err
+	}
+
+	response := rules[position].ToResponse()
+	return &response, nil
+}
+
+func (l *linkRuleService) DeleteLinkRule(ctx context.Context, userID, domain, shortCode, ruleID string) error {
+	link, err := l.getUserLink(ctx, userID, domain, shortCode)
+	if err != nil {
+		return err
+	}
+
+	rules, err := l.lrr.GetRulesByLinkID(ctx, link.ID)
+	if err != nil {
+		return fmt.Errorf("get rules by link ID: %w", err)
+	}
+
+	index := slices.IndexFunc(rules, func(rule models.LinkRule) bool { return rule.ID == ruleID })
+	if index < 0 {
+		return models.ErrLinkRuleNotFound
+	}
+
+	return l.saveRules(ctx, link, slices.Delete(rules, index, index+1))
+}
+
+func (l *linkRuleService) saveRules(ctx context.Context, link *models.Link, rules []models.LinkRule) error {
+	for i := range rules {
+		rules[i].Position = i
+	}
+
+	if err := l.lrr.ReplaceRules(ctx, link.ID, rules); err != nil {
+		return fmt.Errorf("replace rules: %w", err)
+	}
+
+	l.lc.Invalidate(link.DomainKey(), link.ShortCode)
+
+	return nil
+}
+
+func (l *linkRuleService) getUserLink(ctx context.Context, userID, domain, shortCode string) (*models.Link, error) {
+	link, err := l.lr.GetLinkByShortCode(ctx, domain, shortCode)
+	if err != nil {
+		return nil, fmt.Errorf("get link by short code: %w", err)
+	}
+
+	if link == nil {
+		return nil, models.ErrLinkNotFound
+	}
+
+	if link.UserID != userID {
+		return nil, models.ErrLinkNotBelongToUser
+	}
+
+	return link, nil
+}
+
+func normalizeRuleConditions(destinationURL string, conditions []models.RuleCondition) ([]models.RuleCondition, error) {
+	if _, err := url.ParseRequestURI(destinationURL); err != nil {
+		return nil, models.ErrInvalidLinkURL
+	}
+
+	if len(conditions) == 0 || len(conditions) > models.MaxRuleConditions {
+		return nil, models.ErrInvalidRuleConditions
+	}
+
+	normalized := make([]models.RuleCondition, len(conditions))
+	for i, condition := range conditions {
+		var err error
+		if normalized[i], err = normalizeRuleCondition(condition); err != nil {
+			return nil, err
+		}
+	}
+
+	return normalized, nil
+}
+
+func normalizeRuleCondition(condition models.RuleCondition) (models.RuleCondition, error) {
+	normalized := models.RuleCondition{Type: condition.Type, Values: []string{}}
+
+	if len(condition.Values) > models.MaxRuleConditionValues {
+		return normalized, models.ErrInvalidRuleConditionValue
+	}
+
+	for _, value := range condition.Values {
+		value = strings.TrimSpace(value)
+		if value == "" || len(value) > models.MaxRuleValueLength {
+			return normalized, models.ErrInvalidRuleConditionValue
+		}
+
+		switch condition.Type {
+		case models.RuleConditionDevice, models.RuleConditionOS, models.RuleConditionLanguage, models.RuleConditionReferrer:
+			value = strings.ToLower(value)
+		case models.RuleConditionCountry:
+			value = strings.ToUpper(value)
+		}
+
+		normalized.Values = append(normalized.Values, value)
+	}
+
+	if len(normalized.Values) == 0 && condition.Type != models.RuleConditionQuery {
+		return normalized, models.ErrInvalidRuleConditionValue
+	}
+
+	var valid func(value string) bool
+
+	switch condition.Type {
+	case models.RuleConditionDevice:
+		valid = func(value string) bool { return slices.Contains(ruleDevices, value) }
+	case models.RuleConditionOS:
+		valid = func(value string) bool { return slices.Contains(ruleOperatingSystems, value) }
+	case models.RuleConditionLanguage:
+		valid = languageTagPattern.MatchString
+	case models.RuleConditionCountry:
+		valid = countryCodePattern.MatchString
+	case models.RuleConditionReferrer:
+		valid = hostnamePattern.MatchString
+	case models.RuleConditionTime:
+		normalized.Timezone = condition.Timezone
+		if err := resolveRuleCondition(&normalized); err != nil {
+			return normalized, err
+		}
+
+		valid = func(string) bool { return true }
+	case models.RuleConditionQuery:
+		normalized.Key = strings.TrimSpace(condition.Key)
+		if normalized.Key == "" || len(normalized.Key) > models.MaxRuleValueLength {
+			return normalized, models.ErrInvalidRuleConditionValue
+		}
+
+		valid = func(string) bool { return true }
+	default:
+		return normalized, models.ErrInvalidRuleConditionType
+	}
+
+	for _, value := range normalized.Values {
+		if !valid(value) {
+			return normalized, models.ErrInvalidRuleConditionValue
+		}
+	}
+
+	return normalized, nil
+}
+
+type ruleVisitor struct {
+	device       string
+	os           string
+	language     string
+	country      string
+	referrerHost string
+	query        url.Values
+	now          time.Time
+}
+
+func newRuleVisitor(request models.RedirectRequest, now time.Time) ruleVisitor {
+	device, os := parseUserAgent(request.UserAgent)
+
+	visitor := ruleVisitor{
+		device:   device,
+		os:       os,
+		language: preferredLanguage(request.AcceptLanguage),
+		country:  strings.ToUpper(request.Country),
+		query:    request.Query,
+		now:      now,
+	}
+
+	if referrer, err := url.Parse(request.Referrer); err == nil {
+		visitor.referrerHost = strings.ToLower(referrer.Hostname())
+	}
+
+	return visitor
+}
+
+func matchLinkRule(rules []models.LinkRule, visitor ruleVisitor) *models.LinkRule {
+	for i := range rules {
+		if ruleMatches(rules[i], visitor) {
+			return &rules[i]
+		}
+	}
+
+	return nil
+}
+
+func ruleMatches(rule models.LinkRule, visitor ruleVisitor) bool {
+	if len(rule.Conditions) == 0 {
+		return false
+	}
+
+	for _, condition := range rule.Conditions {
+		if !conditionMatches(condition, visitor) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func conditionMatches(condition models.RuleCondition, visitor ruleVisitor) bool {
+	switch condition.Type {
+	case models.RuleConditionDevice:
+		return slices.Contains(condition.Values, visitor.device)
+	case models.RuleConditionOS:
+		return slices.Contains(condition.Values, visitor.os)
+	case models.RuleConditionLanguage:
+		return slices.ContainsFunc(condition.Values, func(value string) bool {
+			return visitor.language == value || strings.HasPrefix(visitor.language, value+"-")
+		})
+	case models.RuleConditionCountry:
+		return visitor.country != "" && slices.Contains(condition.Values, visitor.country)
+	case models.RuleConditionReferrer:
+		return visitor.referrerHost != "" && slices.ContainsFunc(condition.Values, func(value string) bool {
+			return visitor.referrerHost == value || strings.HasSuffix(visitor.referrerHost, "."+value)
+		})
+	case models.RuleConditionTime:
+		if condition.Location == nil {
+			return false
+		}
+
+		local := visitor.now.In(condition.Location)
+		minute := local.Hour()*60 + local.Minute()
+
+		return slices.ContainsFunc(condition.TimeRanges, func(timeRange models.TimeRange) bool {
+			return timeRange.Contains(minute)
+		})
+	case models.RuleConditionQuery:
+		values, ok := visitor.query[condition.Key]
+		if !ok {
+			return false
+		}
+
+		if len(condition.Values) == 0 {
+			return true
+		}
+
+		return slices.ContainsFunc(values, func(value string) bool {
+			return slices.Contains(condition.Values, value)
+		})
+	default:
+		return false
+	}
+}
+
+// resolveLinkRules prepares the time conditions of rules loaded from storage. A
+// condition that no longer resolves is left unresolved and never matches.
+func resolveLinkRules(rules []models.LinkRule) error {
+	var errs []error
+
+	for i := range rules {
+		for j := range rules[i].Conditions {
+			if err := resolveRuleCondition(&rules[i].Conditions[j]); err != nil {
+				errs = append(errs, fmt.Errorf("rule %s: %w", rules[i].ID, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func resolveRuleCondition(condition *models.RuleCondition) error {
+	if condition.Type != models.RuleConditionTime {
+		return nil
+	}
+
+	location := time.UTC
+	if condition.Timezone != "" {
+		loaded, err := time.LoadLocation(condition.Timezone)
+		if err != nil {
+			return models.ErrInvalidRuleConditionValue
+		}
+		location = loaded
+	}
+
+	timeRanges := make([]models.TimeRange, 0, len(condition.Values))
+	for _, value := range condition.Values {
+		start, end, ok := parseTimeRange(value)
+		if !ok {
+			return models.ErrInvalidRuleConditionValue
+		}
+		timeRanges = append(timeRanges, models.TimeRange{Start: start, End: end})
+	}
+
+	condition.Location = location
+	condition.TimeRanges = timeRanges
+
+	return nil
+}
+
+func parseTimeRange(value string) (int, int, bool) {
+	from, to, found := strings.Cut(value, "-")
+	if !found {
+		return 0, 0, false
+	}
+
+	start, err := time.Parse("15:04", strings.TrimSpace(from))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	end, err := time.Parse("15:04", strings.TrimSpace(to))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+
+	return startMinute, endMinute, startMinute != endMinute
+}
+
+func preferredLanguage(acceptLanguage string) string {
+	language, bestQuality := "", 0.0
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		if quality > bestQuality {
+			language, bestQuality = tag, quality
+		}
+	}
+
+	return language
+}
+
+func parseUserAgent(userAgent string) (string, string) {
+	agent := strings.ToLower(userAgent)
+
+	var os string
+	switch {
+	case strings.Contains(agent, "iphone"), strings.Contains(agent, "ipad"), strings.Contains(agent, "ipod"):
+		os = models.OSIOS
+	case strings.Contains(agent, "android"):
+		os = models.OSAndroid
+	case strings.Contains(agent, "windows"):
+		os = models.OSWindows
+	case strings.Contains(agent, "cros"):
+		os = models.OSChromeOS
+	case strings.Contains(agent, "mac os x"), strings.Contains(agent, "macintosh"):
+		os = models.OSMacOS
+	case strings.Contains(agent, "linux"):
+		os = models.OSLinux
+	default:
+		os = models.OSOther
+	}
+
+	var device string
+	switch {
+	case strings.Contains(agent, "bot"), strings.Contains(agent, "crawler"), strings.Contains(agent, "spider"):
+		device = models.DeviceBot
+	case strings.Contains(agent, "ipad"), strings.Contains(agent, "tablet"),
+		os == models.OSAndroid && !strings.Contains(agent, "mobile"):
+		device = models.DeviceTablet
+	case strings.Contains(agent, "mobi"), strings.Contains(agent, "iphone"), strings.Contains(agent, "ipod"):
+		device = models.DeviceMobile
+	default:
+		device = models.DeviceDesktop
+	}
+
+	return device, os
+}
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateLinkRule(t *testing.T) {
+	t.Run("when the rule is valid, it should insert it at the requested position and invalidate the cache", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockRuleRepo := new(mocks.LinkRuleRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		service := &linkRuleService{lr: mockLinkRepo, lrr: mockRuleRepo, lc: mockCache}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: userID}
+		existing := []models.LinkRule{{ID: "r1", LinkID: link.ID, DestinationURL: "https://example.com/r1"}}
+		position := 0
+
+		mockLinkRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockRuleRepo.On("GetRulesByLinkID", ctx, link.ID).Return(existing, nil)
+		mockRuleRepo.On("ReplaceRules", ctx, link.ID, mock.MatchedBy(func(rules []models.LinkRule) bool {
+			return len(rules) == 2 && rules[0].Position == 0 && rules[1].ID == "r1" && rules[1].Position == 1
+		})).Return(nil)
+		mockCache.On("Invalidate", "", link.ShortCode).Return()
+
+		response, err := service.CreateLinkRule(ctx, userID, "", link.ShortCode, models.LinkRulePayload{
+			DestinationURL: "https://example.com/mobile",
+			Conditions:     []models.RuleCondition{{Type: models.RuleConditionDevice, Values: []string{" Mobile "}}},
+			Position:       &position,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, response.Position)
+		assert.Equal(t, []string{"mobile"}, response.Conditions[0].Values)
+		mockRuleRepo.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("when a condition type is unknown, it should return ErrInvalidRuleConditionType", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		service := &linkRuleService{lr: mockLinkRepo}
+
+		_, err := service.CreateLinkRule(context.Background(), uuid.New().String(), "", "abcd1234", models.LinkRulePayload{
+			DestinationURL: "https://example.com",
+			Conditions:     []models.RuleCondition{{Type: "browser", Values: []string{"firefox"}}},
+		})
+
+		assert.Equal(t, models.ErrInvalidRuleConditionType, err)
+		mockLinkRepo.AssertNotCalled(t, "GetLinkByShortCode", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a time range is malformed, it should return ErrInvalidRuleConditionValue", func(t *testing.T) {
+		service := &linkRuleService{}
+
+		_, err := service.CreateLinkRule(context.Background(), uuid.New().String(), "", "abcd1234", models.LinkRulePayload{
+			DestinationURL: "https://example.com",
+			Conditions:     []models.RuleCondition{{Type: models.RuleConditionTime, Values: []string{"9am-5pm"}}},
+		})
+
+		assert.Equal(t, models.ErrInvalidRuleConditionValue, err)
+	})
+
+	t.Run("when the link already has the maximum number of rules, it should return ErrLinkRuleLimitReached", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockRuleRepo := new(mocks.LinkRuleRepositoryMock)
+		service := &linkRuleService{lr: mockLinkRepo, lrr: mockRuleRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: userID}
+
+		mockLinkRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockRuleRepo.On("GetRulesByLinkID", ctx, link.ID).Return(make([]models.LinkRule, models.MaxLinkRules), nil)
+
+		_, err := service.CreateLinkRule(ctx, userID, "", link.ShortCode, models.LinkRulePayload{
+			DestinationURL: "https://example.com",
+			Conditions:     []models.RuleCondition{{Type: models.RuleConditionCountry, Values: []string{"br"}}},
+		})
+
+		assert.Equal(t, models.ErrLinkRuleLimitReached, err)
+		mockRuleRepo.AssertNotCalled(t, "ReplaceRules", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdateLinkRule(t *testing.T) {
+	t.Run("when the rule is moved, it should reorder the remaining rules", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockRuleRepo := new(mocks.LinkRuleRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		service := &linkRuleService{lr: mockLinkRepo, lrr: mockRuleRepo, lc: mockCache}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: userID}
+		existing := []models.LinkRule{{ID: "r1"}, {ID: "r2"}, {ID: "r3"}}
+		position := 2
+
+		mockLinkRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockRuleRepo.On("GetRulesByLinkID", ctx, link.ID).Return(existing, nil)
+		mockRuleRepo.On("ReplaceRules", ctx, link.ID, mock.MatchedBy(func(rules []models.LinkRule) bool {
+			return rules[0].ID == "r2" && rules[1].ID == "r3" && rules[2].ID == "r1" && rules[2].UpdatedAt.Valid
+		})).Return(nil)
+		mockCache.On("Invalidate", "", link.ShortCode).Return()
+
+		response, err := service.UpdateLinkRule(ctx, userID, "", link.ShortCode, "r1", models.LinkRulePayload{
+			DestinationURL: "https://example.com/campaign",
+			Conditions:     []models.RuleCondition{{Type: models.RuleConditionQuery, Key: "utm_source"}},
+			Position:       &position,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, response.Position)
+		mockRuleRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the rule does not exist, it should return ErrLinkRuleNotFound", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockRuleRepo := new(mocks.LinkRuleRepositoryMock)
+		service := &linkRuleService{lr: mockLinkRepo, lrr: mockRuleRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: userID}
+
+		mockLinkRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockRuleRepo.On("GetRulesByLinkID", ctx, link.ID).Return([]models.LinkRule{{ID: "r1"}}, nil)
+
+		_, err := service.UpdateLinkRule(ctx, userID, "", link.ShortCode, "missing", models.LinkRulePayload{
+			DestinationURL: "https://example.com",
+			Conditions:     []models.RuleCondition{{Type: models.RuleConditionOS, Values: []string{"ios"}}},
+		})
+
+		assert.Equal(t, models.ErrLinkRuleNotFound, err)
+	})
+}
+
+func TestDeleteLinkRule(t *testing.T) {
+	t.Run("when the link belongs to another user, it should return ErrLinkNotBelongToUser", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockRuleRepo := new(mocks.LinkRuleRepositoryMock)
+		service := &linkRuleService{lr: mockLinkRepo, lrr: mockRuleRepo}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: uuid.New().String()}
+
+		mockLinkRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+
+		err := service.DeleteLinkRule(ctx, uuid.New().String(), "", link.ShortCode, "r1")
+
+		assert.Equal(t, models.ErrLinkNotBelongToUser, err)
+		mockRuleRepo.AssertNotCalled(t, "GetRulesByLinkID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMatchLinkRule(t *testing.T) {
+	iphone := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 Mobile/15E148"
+	now := time.Date(2025, 1, 2, 23, 30, 0, 0, time.UTC)
+
+	rules := []models.LinkRule{
+		{ID: "night", Conditions: []models.RuleCondition{{Type: models.RuleConditionTime, Values: []string{"22:00-06:00"}, Timezone: "America/Sao_Paulo"}}},
+		{ID: "ios-pt", Conditions: []models.RuleCondition{
+			{Type: models.RuleConditionOS, Values: []string{"ios"}},
+			{Type: models.RuleConditionLanguage, Values: []string{"pt"}},
+		}},
+		{ID: "newsletter", Conditions: []models.RuleCondition{{Type: models.RuleConditionQuery, Key: "utm_source", Values: []string{"newsletter"}}}},
+		{ID: "search", Conditions: []models.RuleCondition{{Type: models.RuleConditionReferrer, Values: []string{"google.com"}}}},
+	}
+	assert.NoError(t, resolveLinkRules(rules))
+
+	t.Run("when several rules match, it should return the first one in order", func(t *testing.T) {
+		request := models.RedirectRequest{UserAgent: iphone, AcceptLanguage: "en;q=0.5, pt-BR", Query: url.Values{"utm_source": {"newsletter"}}}
+
+		rule := matchLinkRule(rules, newRuleVisitor(request, now))
+
+		assert.Equal(t, "ios-pt", rule.ID)
+	})
+
+	t.Run("when the time range wraps around midnight in the rule timezone, it should match", func(t *testing.T) {
+		rule := matchLinkRule(rules, newRuleVisitor(models.RedirectRequest{}, time.Date(2025, 1, 3, 2, 0, 0, 0, time.UTC)))
+
+		assert.Equal(t, "night", rule.ID)
+	})
+
+	t.Run("when the referrer is a subdomain of the rule host, it should match", func(t *testing.T) {
+		request := models.RedirectRequest{Referrer: "https://www.google.com/search?q=fizz"}
+
+		rule := matchLinkRule(rules, newRuleVisitor(request, now))
+
+		assert.Equal(t, "search", rule.ID)
+	})
+
+	t.Run("when no rule matches, it should return nil", func(t *testing.T) {
+		request := models.RedirectRequest{UserAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64)", Referrer: "https://notgoogle.com"}
+
+		assert.Nil(t, matchLinkRule(rules, newRuleVisitor(request, now)))
+	})
+
+	t.Run("when a stored timezone cannot be resolved, it should report it and never match", func(t *testing.T) {
+		stored := []models.LinkRule{
+			{ID: "broken", Conditions: []models.RuleCondition{{Type: models.RuleConditionTime, Values: []string{"00:00-23:59"}, Timezone: "Mars/Olympus_Mons"}}},
+		}
+
+		err := resolveLinkRules(stored)
+
+		assert.ErrorIs(t, err, models.ErrInvalidRuleConditionValue)
+		assert.Nil(t, matchLinkRule(stored, newRuleVisitor(models.RedirectRequest{}, now)))
+	})
+}
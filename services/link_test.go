@@ -2,8 +2,12 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/g-villarinho/link-fizz-api/config"
 	"github.com/g-villarinho/link-fizz-api/mocks"
@@ -15,70 +19,381 @@ import (
 
 func TestCreateLink(t *testing.T) {
 	t.Run("when creation is successful, it should not return an error", func(t *testing.T) {
-		mockUtils := new(mocks.UtilsServiceMock)
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
 		mockRepo := new(mocks.LinkRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
 
 		service := &linkService{
-			us: mockUtils,
+			sg: mockGenerator,
 			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			lc: mockCache,
 		}
 
 		ctx := context.Background()
 		expectedShortCode := "abcd1234"
 		userID := uuid.New().String()
 
-		mockUtils.On("GenerateShortCode", shortCodeLength).Return(expectedShortCode, nil)
+		mockGenerator.On("Generate", ctx, "").Return(expectedShortCode, nil)
 		mockRepo.On("CreateLink", mock.Anything, mock.Anything).Return(nil)
+		mockCache.On("Invalidate", "", expectedShortCode).Return()
 
-		_, err := service.CreateLink(ctx, userID, "https://example.com", nil, nil)
+		_, err := service.CreateLink(ctx, userID, models.LinkPayload{DestinationURL: "https://example.com"})
 
 		assert.NoError(t, err)
-		mockUtils.AssertExpectations(t)
+		mockGenerator.AssertExpectations(t)
 		mockRepo.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
 	})
 
 	t.Run("when failing to generate the short code, it should return an error", func(t *testing.T) {
-		mockUtils := new(mocks.UtilsServiceMock)
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
 		mockRepo := new(mocks.LinkRepositoryMock)
 
 		service := &linkService{
-			us: mockUtils,
+			sg: mockGenerator,
 			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
 		}
 
 		ctx := context.Background()
-		mockUtils.On("GenerateShortCode", shortCodeLength).Return("", errors.New("failed to generate short code"))
+		mockGenerator.On("Generate", ctx, "").Return("", errors.New("failed to generate short code"))
 		userID := uuid.New().String()
-		_, err := service.CreateLink(ctx, userID, "https://example.com", nil, nil)
+		_, err := service.CreateLink(ctx, userID, models.LinkPayload{DestinationURL: "https://example.com"})
 
 		assert.Error(t, err)
 		assert.Equal(t, "generate short code: failed to generate short code", err.Error())
-		mockUtils.AssertExpectations(t)
+		mockGenerator.AssertExpectations(t)
+	})
+
+	t.Run("when the expiration is in the past, it should return ErrInvalidExpiration", func(t *testing.T) {
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			sg: mockGenerator,
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+		}
+
+		ctx := context.Background()
+		expiresAt := time.Now().Add(-time.Hour)
+		userID := uuid.New().String()
+
+		_, err := service.CreateLink(ctx, userID, models.LinkPayload{DestinationURL: "https://example.com", ExpiresAt: &expiresAt})
+
+		assert.Equal(t, models.ErrInvalidExpiration, err)
+		mockRepo.AssertNotCalled(t, "CreateLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the activation is after the expiration, it should return ErrInvalidActivationWindow", func(t *testing.T) {
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			sg: mockGenerator,
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+		}
+
+		ctx := context.Background()
+		activatesAt := time.Now().Add(2 * time.Hour)
+		expiresAt := time.Now().Add(time.Hour)
+		userID := uuid.New().String()
+
+		_, err := service.CreateLink(ctx, userID, models.LinkPayload{
+			DestinationURL: "https://example.com",
+			ActivatesAt:    &activatesAt,
+			ExpiresAt:      &expiresAt,
+		})
+
+		assert.Equal(t, models.ErrInvalidActivationWindow, err)
+		mockRepo.AssertNotCalled(t, "CreateLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the custom code is reserved, it should return ErrReservedShortCode", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		registry := newReservedCodeRegistry()
+		registry.Reserve("login")
+		service := &linkService{
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			rc: registry,
+		}
+
+		ctx := context.Background()
+		customCode := "Login"
+
+		_, err := service.CreateLink(ctx, uuid.New().String(), models.LinkPayload{DestinationURL: "https://example.com", CustomCode: &customCode})
+
+		assert.Equal(t, models.ErrReservedShortCode, err)
+		mockRepo.AssertNotCalled(t, "GetLinkByShortCode", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "CreateLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the custom code has unsupported characters, it should return ErrInvalidShortCode", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			rc: newReservedCodeRegistry(),
+		}
+
+		ctx := context.Background()
+		customCode := "me/links"
+
+		_, err := service.CreateLink(ctx, uuid.New().String(), models.LinkPayload{DestinationURL: "https://example.com", CustomCode: &customCode})
+
+		assert.Equal(t, models.ErrInvalidShortCode, err)
+		mockRepo.AssertNotCalled(t, "CreateLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a concurrent insert takes the generated code, it should retry with a new code", func(t *testing.T) {
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		service := &linkService{
+			sg: mockGenerator,
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			lc: mockCache,
+		}
+
+		ctx := context.Background()
+
+		mockGenerator.On("Generate", ctx, "").Return("first123", nil).Once()
+		mockGenerator.On("Generate", ctx, "").Return("second12", nil).Once()
+		mockRepo.On("CreateLink", ctx, mock.MatchedBy(func(l models.Link) bool { return l.ShortCode == "first123" })).Return(models.ErrShortCodeTaken)
+		mockRepo.On("CreateLink", ctx, mock.MatchedBy(func(l models.Link) bool { return l.ShortCode == "second12" })).Return(nil)
+		mockCache.On("Invalidate", "", "second12").Return()
+
+		response, err := service.CreateLink(ctx, uuid.New().String(), models.LinkPayload{DestinationURL: "https://example.com"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "second12", response.ShortCode)
+		mockGenerator.AssertExpectations(t)
+	})
+
+	t.Run("when a concurrent insert takes the custom code, it should return ErrCustomCodeAlreadyExists", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			rc: newReservedCodeRegistry(),
+		}
+
+		ctx := context.Background()
+		customCode := "promo"
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", customCode).Return(nil, nil)
+		mockRepo.On("CreateLink", ctx, mock.Anything).Return(models.ErrShortCodeTaken)
+
+		_, err := service.CreateLink(ctx, uuid.New().String(), models.LinkPayload{DestinationURL: "https://example.com", CustomCode: &customCode})
+
+		assert.Equal(t, models.ErrCustomCodeAlreadyExists, err)
+	})
+
+	t.Run("when the custom code is taken only on another domain, it should create the link", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockDomains := new(mocks.DomainServiceMock)
+		service := &linkService{
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			rc: newReservedCodeRegistry(),
+			lc: newMemoryLinkCache(models.LinkCache{}),
+			ds: mockDomains,
+		}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		hostname := "go.acme.com"
+		customCode := "promo"
+
+		mockDomains.On("GetVerifiedDomain", ctx, userID, hostname).Return(&models.Domain{Hostname: hostname}, nil)
+		mockRepo.On("GetLinkByShortCode", ctx, hostname, customCode).Return(nil, nil)
+		mockRepo.On("CreateLink", ctx, mock.Anything).Return(nil)
+
+		response, err := service.CreateLink(ctx, userID, models.LinkPayload{DestinationURL: "https://example.com", CustomCode: &customCode, Domain: &hostname})
+
+		assert.NoError(t, err)
+		assert.Equal(t, customCode, response.ShortCode)
+		mockRepo.AssertNotCalled(t, "GetLinkByShortCode", ctx, "", customCode)
+	})
+
+	t.Run("when a verified domain is given, it should attach it to the link", func(t *testing.T) {
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockDomains := new(mocks.DomainServiceMock)
+		service := &linkService{
+			sg: mockGenerator,
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			lc: newMemoryLinkCache(models.LinkCache{}),
+			ds: mockDomains,
+		}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		hostname := "go.acme.com"
+
+		mockDomains.On("GetVerifiedDomain", ctx, userID, hostname).Return(&models.Domain{Hostname: hostname}, nil)
+		mockGenerator.On("Generate", ctx, hostname).Return("abcd1234", nil)
+		mockRepo.On("CreateLink", ctx, mock.MatchedBy(func(l models.Link) bool {
+			return l.Domain.Valid && l.Domain.String == hostname
+		})).Return(nil)
+
+		_, err := service.CreateLink(ctx, userID, models.LinkPayload{DestinationURL: "https://example.com", Domain: &hostname})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the domain is not verified, it should return ErrDomainNotVerified", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockDomains := new(mocks.DomainServiceMock)
+		service := &linkService{
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+			ds: mockDomains,
+		}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		hostname := "go.acme.com"
+
+		mockDomains.On("GetVerifiedDomain", ctx, userID, hostname).Return(nil, models.ErrDomainNotVerified)
+
+		_, err := service.CreateLink(ctx, userID, models.LinkPayload{DestinationURL: "https://example.com", Domain: &hostname})
+
+		assert.Equal(t, models.ErrDomainNotVerified, err)
+		mockRepo.AssertNotCalled(t, "CreateLink", mock.Anything, mock.Anything)
 	})
 
 	t.Run("when failing to create the link in the repository, it should return an error", func(t *testing.T) {
-		mockUtils := new(mocks.UtilsServiceMock)
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
 		mockRepo := new(mocks.LinkRepositoryMock)
 
 		service := &linkService{
-			us: mockUtils,
+			sg: mockGenerator,
 			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
 		}
 
 		ctx := context.Background()
 		expectedShortCode := "abcd1234"
 
-		mockUtils.On("GenerateShortCode", shortCodeLength).Return(expectedShortCode, nil)
+		mockGenerator.On("Generate", ctx, "").Return(expectedShortCode, nil)
 		mockRepo.On("CreateLink", mock.Anything, mock.Anything).Return(errors.New("repository error"))
 
 		userID := uuid.New().String()
-		_, err := service.CreateLink(ctx, userID, "https://example.com", nil, nil)
+		_, err := service.CreateLink(ctx, userID, models.LinkPayload{DestinationURL: "https://example.com"})
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "create link: repository error")
-		mockUtils.AssertExpectations(t)
+		mockGenerator.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("when no redirect type is given, it should use the account default and warn about permanent redirects", func(t *testing.T) {
+		mockGenerator := new(mocks.ShortCodeGeneratorMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			sg: mockGenerator,
+			lr: mockRepo,
+			ur: newUserRepositoryStub(&models.User{DefaultRedirectType: http.StatusMovedPermanently}),
+			lc: newMemoryLinkCache(models.LinkCache{}),
+		}
+
+		ctx := context.Background()
+
+		mockGenerator.On("Generate", ctx, "").Return("abcd1234", nil)
+		mockRepo.On("CreateLink", ctx, mock.MatchedBy(func(l models.Link) bool {
+			return l.RedirectType == http.StatusMovedPermanently
+		})).Return(nil)
+
+		response, err := service.CreateLink(ctx, uuid.New().String(), models.LinkPayload{DestinationURL: "https://example.com"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusMovedPermanently, response.RedirectType)
+		assert.NotEmpty(t, response.Warnings)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("when the redirect type is not supported, it should return ErrInvalidRedirectType", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+		}
+
+		redirectType := http.StatusOK
+		_, err := service.CreateLink(context.Background(), uuid.New().String(), models.LinkPayload{DestinationURL: "https://example.com", RedirectType: &redirectType})
+
+		assert.Equal(t, models.ErrInvalidRedirectType, err)
+		mockRepo.AssertNotCalled(t, "CreateLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a single destination is given, it should return ErrInvalidLinkDestinations", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{
+			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
+		}
+
+		_, err := service.CreateLink(context.Background(), uuid.New().String(), models.LinkPayload{
+			DestinationURL: "https://example.com",
+			Destinations:   []models.LinkDestinationPayload{{URL: "https://example.com/a", Weight: 50}},
+		})
+
+		assert.Equal(t, models.ErrInvalidLinkDestinations, err)
+		mockRepo.AssertNotCalled(t, "CreateLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a destination weight is out of range, it should return ErrInvalidDestinationWeight", func(t *testing.T) {
+		service := &linkService{ur: newUserRepositoryStub(nil)}
+
+		_, err := service.CreateLink(context.Background(), uuid.New().String(), models.LinkPayload{
+			DestinationURL: "https://example.com",
+			Destinations: []models.LinkDestinationPayload{
+				{URL: "https://example.com/a", Weight: 50},
+				{URL: "https://example.com/b", Weight: 0},
+			},
+		})
+
+		assert.Equal(t, models.ErrInvalidDestinationWeight, err)
+	})
+
+	t.Run("when verification is required and the email is not verified, it should return ErrEmailNotVerified", func(t *testing.T) {
+		setEmailVerification(t, models.EmailVerification{RequiredForLinks: true})
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo, ur: newUserRepositoryStub(&models.User{DefaultRedirectType: models.DefaultRedirectType})}
+
+		_, err := service.CreateLink(context.Background(), uuid.New().String(), models.LinkPayload{DestinationURL: "https://example.com"})
+
+		assert.Equal(t, models.ErrEmailNotVerified, err)
+		mockRepo.AssertNotCalled(t, "CreateLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when verification is required and the email is not verified, it should reject batches and imports", func(t *testing.T) {
+		setEmailVerification(t, models.EmailVerification{RequiredForLinks: true})
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo, ur: newUserRepositoryStub(&models.User{DefaultRedirectType: models.DefaultRedirectType})}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		_, err := service.CreateLinks(ctx, userID, []models.LinkPayload{{DestinationURL: "https://example.com"}})
+		assert.Equal(t, models.ErrEmailNotVerified, err)
+
+		_, err = service.ImportLinks(ctx, userID, strings.NewReader("destination\nhttps://example.com\n"))
+		assert.Equal(t, models.ErrEmailNotVerified, err)
+
+		mockRepo.AssertNotCalled(t, "CreateLinks", mock.Anything, mock.Anything)
+	})
+}
+
+func newUserRepositoryStub(user *models.User) *mocks.UserRepositoryMock {
+	mockUserRepository := new(mocks.UserRepositoryMock)
+	mockUserRepository.On("GetUserByID", mock.Anything, mock.Anything).Return(user, nil)
+	return mockUserRepository
 }
 
 func TestGetOriginalURLByShortCode(t *testing.T) {
@@ -87,15 +402,16 @@ func TestGetOriginalURLByShortCode(t *testing.T) {
 
 		service := &linkService{
 			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
 		}
 
 		ctx := context.Background()
 		shortCode := "abcd1234"
 		expectedURL := "https://example.com"
 
-		mockRepo.On("GetOriginalURLByShortCode", ctx, shortCode).Return(expectedURL, nil)
+		mockRepo.On("GetOriginalURLByShortCode", ctx, "", shortCode).Return(expectedURL, nil)
 
-		url, err := service.GetOriginalURLByShortCode(ctx, shortCode)
+		url, err := service.GetOriginalURLByShortCode(ctx, "", shortCode)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedURL, url)
@@ -107,14 +423,15 @@ func TestGetOriginalURLByShortCode(t *testing.T) {
 
 		service := &linkService{
 			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
 		}
 
 		ctx := context.Background()
 		shortCode := "nonexistent"
 
-		mockRepo.On("GetOriginalURLByShortCode", ctx, shortCode).Return("", nil)
+		mockRepo.On("GetOriginalURLByShortCode", ctx, "", shortCode).Return("", nil)
 
-		url, err := service.GetOriginalURLByShortCode(ctx, shortCode)
+		url, err := service.GetOriginalURLByShortCode(ctx, "", shortCode)
 
 		assert.Error(t, err)
 		assert.Equal(t, models.ErrLinkNotFound, err)
@@ -127,14 +444,15 @@ func TestGetOriginalURLByShortCode(t *testing.T) {
 
 		service := &linkService{
 			lr: mockRepo,
+			ur: newUserRepositoryStub(nil),
 		}
 
 		ctx := context.Background()
 		shortCode := "errorcase"
 
-		mockRepo.On("GetOriginalURLByShortCode", ctx, shortCode).Return("", errors.New("database error"))
+		mockRepo.On("GetOriginalURLByShortCode", ctx, "", shortCode).Return("", errors.New("database error"))
 
-		url, err := service.GetOriginalURLByShortCode(ctx, shortCode)
+		url, err := service.GetOriginalURLByShortCode(ctx, "", shortCode)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "get original URL by short code: database error")
@@ -151,14 +469,18 @@ func TestGetShortURLs(t *testing.T) {
 		service := &linkService{lr: mockRepo}
 
 		ctx := context.Background()
-		expectedShortCodes := []string{"abc123", "def456", "ghi789"}
+		addresses := []models.LinkAddress{
+			{ShortCode: "abc123"},
+			{ShortCode: "def456"},
+			{Domain: "go.acme.com", ShortCode: "ghi789"},
+		}
 		expectedURLs := []string{
 			"https://api.example.com/abc123",
 			"https://api.example.com/def456",
-			"https://api.example.com/ghi789",
+			"https://go.acme.com/ghi789",
 		}
 
-		mockRepo.On("GetAllShortCodes", ctx).Return(expectedShortCodes, nil)
+		mockRepo.On("GetLinkAddressesByUserID", ctx, mock.Anything).Return(addresses, nil)
 
 		userID := uuid.New().String()
 		result, err := service.GetUsersShortURLs(ctx, userID)
@@ -173,9 +495,7 @@ func TestGetShortURLs(t *testing.T) {
 		service := &linkService{lr: mockRepo}
 
 		ctx := context.Background()
-		emptyShortCodes := []string{}
-
-		mockRepo.On("GetAllShortCodes", ctx).Return(emptyShortCodes, nil)
+		mockRepo.On("GetLinkAddressesByUserID", ctx, mock.Anything).Return([]models.LinkAddress{}, nil)
 
 		userID := uuid.New().String()
 		result, err := service.GetUsersShortURLs(ctx, userID)
@@ -193,14 +513,14 @@ func TestGetShortURLs(t *testing.T) {
 		ctx := context.Background()
 		expectedError := errors.New("database error")
 
-		mockRepo.On("GetAllShortCodes", ctx).Return(nil, expectedError)
+		mockRepo.On("GetLinkAddressesByUserID", ctx, mock.Anything).Return(nil, expectedError)
 
 		userID := uuid.New().String()
 		result, err := service.GetUsersShortURLs(ctx, userID)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "get all short codes: database error")
+		assert.Contains(t, err.Error(), "get link addresses: database error")
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -213,10 +533,10 @@ func TestGetShortURLs(t *testing.T) {
 		defer func() { config.Env.APIURL = originalAPIURL }()
 
 		ctx := context.Background()
-		shortCodes := []string{"test123"}
+		addresses := []models.LinkAddress{{ShortCode: "test123"}}
 		expectedURL := "https://test.example.com/test123"
 
-		mockRepo.On("GetAllShortCodes", ctx).Return(shortCodes, nil)
+		mockRepo.On("GetLinkAddressesByUserID", ctx, mock.Anything).Return(addresses, nil)
 
 		userID := uuid.New().String()
 		result, err := service.GetUsersShortURLs(ctx, userID)
@@ -226,3 +546,401 @@ func TestGetShortURLs(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestUpdateLink(t *testing.T) {
+	t.Run("when the link belongs to the user, it should update, set UpdatedAt and invalidate the cache", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		mockTagService := new(mocks.TagServiceMock)
+		service := &linkService{lr: mockRepo, lc: mockCache, ts: mockTagService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		shortCode := "abcd1234"
+		newURL := "https://example.com/new"
+		link := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com", ShortCode: shortCode, UserID: userID}
+		tags := []models.Tag{{ID: uuid.New().String(), UserID: userID, Name: "campaign"}}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", shortCode).Return(link, nil)
+		mockTagService.On("GetLinkTags", ctx, []string{link.ID}).Return(map[string][]models.Tag{link.ID: tags}, nil)
+		mockRepo.On("UpdateLink", ctx, mock.MatchedBy(func(l models.Link) bool {
+			return l.OriginalURL == newURL && l.UpdatedAt.Valid && len(l.Tags) == 1
+		})).Return(nil)
+		mockCache.On("Invalidate", "", shortCode).Return()
+
+		_, err := service.UpdateLink(ctx, userID, "", shortCode, models.UpdateLinkPayload{DestinationURL: &newURL})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("when the redirect type is switched to permanent, it should return the caching warning", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		mockTagService := new(mocks.TagServiceMock)
+		service := &linkService{lr: mockRepo, lc: mockCache, ts: mockTagService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		redirectType := http.StatusPermanentRedirect
+		link := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com", ShortCode: "abcd1234", UserID: userID, RedirectType: http.StatusFound}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockTagService.On("GetLinkTags", ctx, []string{link.ID}).Return(map[string][]models.Tag{}, nil)
+		mockRepo.On("UpdateLink", ctx, mock.MatchedBy(func(l models.Link) bool {
+			return l.RedirectType == http.StatusPermanentRedirect
+		})).Return(nil)
+		mockCache.On("Invalidate", "", link.ShortCode).Return()
+
+		response, err := service.UpdateLink(ctx, userID, "", link.ShortCode, models.UpdateLinkPayload{RedirectType: &redirectType})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusPermanentRedirect, response.RedirectType)
+		assert.Equal(t, []string{models.PermanentRedirectWarning}, response.Warnings)
+	})
+
+	t.Run("when destinations are reweighted, it should keep the IDs of unchanged URLs", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		mockTagService := new(mocks.TagServiceMock)
+		service := &linkService{lr: mockRepo, lc: mockCache, ts: mockTagService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com",
+			ShortCode:   "abcd1234",
+			UserID:      userID,
+			Destinations: []models.LinkDestination{
+				{ID: "a", URL: "https://example.com/a", Weight: 50},
+				{ID: "b", URL: "https://example.com/b", Weight: 50},
+			},
+		}
+		destinations := []models.LinkDestinationPayload{
+			{URL: "https://example.com/a", Weight: 80},
+			{URL: "https://example.com/c", Weight: 20},
+		}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockTagService.On("GetLinkTags", ctx, []string{link.ID}).Return(map[string][]models.Tag{}, nil)
+		mockRepo.On("UpdateLink", ctx, mock.MatchedBy(func(l models.Link) bool {
+			return len(l.Destinations) == 2 &&
+				l.Destinations[0].ID == "a" && l.Destinations[0].Weight == 80 &&
+				l.Destinations[1].ID != "b" && l.Destinations[1].URL == "https://example.com/c"
+		})).Return(nil)
+		mockCache.On("Invalidate", "", link.ShortCode).Return()
+
+		_, err := service.UpdateLink(ctx, userID, "", link.ShortCode, models.UpdateLinkPayload{Destinations: &destinations})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the schedule is cleared, it should remove the activation and expiration dates", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		mockTagService := new(mocks.TagServiceMock)
+		service := &linkService{lr: mockRepo, lc: mockCache, ts: mockTagService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		now := time.Now().UTC()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com",
+			ShortCode:   "abcd1234",
+			UserID:      userID,
+			ActivatesAt: sql.NullTime{Time: now.Add(time.Hour), Valid: true},
+			ExpiresAt:   sql.NullTime{Time: now.Add(2 * time.Hour), Valid: true},
+		}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockTagService.On("GetLinkTags", ctx, []string{link.ID}).Return(map[string][]models.Tag{}, nil)
+		mockRepo.On("UpdateLink", ctx, mock.MatchedBy(func(l models.Link) bool {
+			return !l.ActivatesAt.Valid && !l.ExpiresAt.Valid
+		})).Return(nil)
+		mockCache.On("Invalidate", "", link.ShortCode).Return()
+
+		_, err := service.UpdateLink(ctx, userID, "", link.ShortCode, models.UpdateLinkPayload{ClearActivatesAt: true, ClearExpiresAt: true})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the click limit is cleared, it should remove max clicks", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		mockTagService := new(mocks.TagServiceMock)
+		service := &linkService{lr: mockRepo, lc: mockCache, ts: mockTagService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com",
+			ShortCode:   "abcd1234",
+			UserID:      userID,
+			MaxClicks:   sql.NullInt64{Int64: 1, Valid: true},
+		}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockTagService.On("GetLinkTags", ctx, []string{link.ID}).Return(map[string][]models.Tag{}, nil)
+		mockRepo.On("UpdateLink", ctx, mock.MatchedBy(func(l models.Link) bool {
+			return !l.MaxClicks.Valid
+		})).Return(nil)
+		mockCache.On("Invalidate", "", link.ShortCode).Return()
+
+		_, err := service.UpdateLink(ctx, userID, "", link.ShortCode, models.UpdateLinkPayload{ClearMaxClicks: true})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("when max clicks is set and cleared at once, it should return ErrConflictingMaxClicks", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo}
+		maxClicks := int64(10)
+
+		_, err := service.UpdateLink(context.Background(), uuid.New().String(), "", "abcd1234", models.UpdateLinkPayload{MaxClicks: &maxClicks, ClearMaxClicks: true})
+
+		assert.Equal(t, models.ErrConflictingMaxClicks, err)
+		mockRepo.AssertNotCalled(t, "GetLinkByShortCode", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a schedule field is set and cleared at once, it should return ErrConflictingSchedule", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo}
+		expiresAt := time.Now().Add(time.Hour)
+
+		_, err := service.UpdateLink(context.Background(), uuid.New().String(), "", "abcd1234", models.UpdateLinkPayload{ExpiresAt: &expiresAt, ClearExpiresAt: true})
+
+		assert.Equal(t, models.ErrConflictingSchedule, err)
+		mockRepo.AssertNotCalled(t, "GetLinkByShortCode", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the link does not exist, it should return ErrLinkNotFound", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo}
+
+		ctx := context.Background()
+		shortCode := "nonexistent"
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", shortCode).Return(nil, nil)
+
+		_, err := service.UpdateLink(ctx, uuid.New().String(), "", shortCode, models.UpdateLinkPayload{})
+
+		assert.Equal(t, models.ErrLinkNotFound, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the link belongs to another user, it should return ErrLinkNotBelongToUser", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo}
+
+		ctx := context.Background()
+		shortCode := "abcd1234"
+		link := &models.Link{ID: uuid.New().String(), ShortCode: shortCode, UserID: uuid.New().String()}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", shortCode).Return(link, nil)
+
+		_, err := service.UpdateLink(ctx, uuid.New().String(), "", shortCode, models.UpdateLinkPayload{})
+
+		assert.Equal(t, models.ErrLinkNotBelongToUser, err)
+		mockRepo.AssertNotCalled(t, "UpdateLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when tags and folder are given, it should replace the tags and move the link", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		mockTagService := new(mocks.TagServiceMock)
+		mockFolderService := new(mocks.FolderServiceMock)
+		service := &linkService{lr: mockRepo, lc: mockCache, ts: mockTagService, fs: mockFolderService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		shortCode := "abcd1234"
+		folderID := uuid.New().String()
+		tagIDs := []string{uuid.New().String()}
+		link := &models.Link{ID: uuid.New().String(), ShortCode: shortCode, UserID: userID}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", shortCode).Return(link, nil)
+		mockFolderService.On("GetUserFolder", ctx, userID, folderID).Return(&models.Folder{ID: folderID, UserID: userID}, nil)
+		mockTagService.On("GetUserTags", ctx, userID, tagIDs).Return([]models.Tag{{ID: tagIDs[0], UserID: userID}}, nil)
+		mockRepo.On("UpdateLink", ctx, mock.MatchedBy(func(l models.Link) bool {
+			return l.FolderID.String == folderID && len(l.Tags) == 1 && l.Tags[0].ID == tagIDs[0]
+		})).Return(nil)
+		mockCache.On("Invalidate", "", shortCode).Return()
+
+		_, err := service.UpdateLink(ctx, userID, "", shortCode, models.UpdateLinkPayload{FolderID: &folderID, TagIDs: &tagIDs})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockTagService.AssertNotCalled(t, "GetLinkTags", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a tag belongs to another user, it should return ErrTagNotBelongToUser", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockTagService := new(mocks.TagServiceMock)
+		service := &linkService{lr: mockRepo, ts: mockTagService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		shortCode := "abcd1234"
+		tagIDs := []string{uuid.New().String()}
+		link := &models.Link{ID: uuid.New().String(), ShortCode: shortCode, UserID: userID}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", shortCode).Return(link, nil)
+		mockTagService.On("GetUserTags", ctx, userID, tagIDs).Return(nil, models.ErrTagNotBelongToUser)
+
+		_, err := service.UpdateLink(ctx, userID, "", shortCode, models.UpdateLinkPayload{TagIDs: &tagIDs})
+
+		assert.Equal(t, models.ErrTagNotBelongToUser, err)
+		mockRepo.AssertNotCalled(t, "UpdateLink", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteLink(t *testing.T) {
+	t.Run("when the link belongs to the user, it should delete it and invalidate the cache", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockCache := new(mocks.LinkCacheMock)
+		service := &linkService{lr: mockRepo, lc: mockCache}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		shortCode := "abcd1234"
+		link := &models.Link{ID: uuid.New().String(), ShortCode: shortCode, UserID: userID}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", shortCode).Return(link, nil)
+		mockRepo.On("DeleteLink", ctx, link.ID).Return(nil)
+		mockCache.On("Invalidate", "", shortCode).Return()
+
+		err := service.DeleteLink(ctx, userID, "", shortCode)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("when the link belongs to another user, it should return ErrLinkNotBelongToUser", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo}
+
+		ctx := context.Background()
+		shortCode := "abcd1234"
+		link := &models.Link{ID: uuid.New().String(), ShortCode: shortCode, UserID: uuid.New().String()}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", shortCode).Return(link, nil)
+
+		err := service.DeleteLink(ctx, uuid.New().String(), "", shortCode)
+
+		assert.Equal(t, models.ErrLinkNotBelongToUser, err)
+		mockRepo.AssertNotCalled(t, "DeleteLink", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the repository fails, it should return an error", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		shortCode := "abcd1234"
+		link := &models.Link{ID: uuid.New().String(), ShortCode: shortCode, UserID: userID}
+
+		mockRepo.On("GetLinkByShortCode", ctx, "", shortCode).Return(link, nil)
+		mockRepo.On("DeleteLink", ctx, link.ID).Return(errors.New("database error"))
+
+		err := service.DeleteLink(ctx, userID, "", shortCode)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "delete link: database error")
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestGetLinksByUserID(t *testing.T) {
+	config.Env.APIURL = "https://api.example.com"
+
+	t.Run("when there are more links than the limit, it should return a next cursor", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockTagService := new(mocks.TagServiceMock)
+		service := &linkService{lr: mockRepo, ts: mockTagService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		now := time.Now().UTC()
+		links := []models.Link{
+			{ID: "3", OriginalURL: "https://example.com/3", ShortCode: "c", UserID: userID, CreatedAt: now},
+			{ID: "2", OriginalURL: "https://example.com/2", ShortCode: "b", UserID: userID, CreatedAt: now.Add(-time.Minute)},
+			{ID: "1", OriginalURL: "https://example.com/1", ShortCode: "a", UserID: userID, CreatedAt: now.Add(-2 * time.Minute)},
+		}
+
+		mockRepo.On("GetLinksByUserID", ctx, userID, mock.MatchedBy(func(q models.LinkQuery) bool {
+			return q.Limit == 3 && q.Sort == models.LinkSortCreated && q.Order == models.SortDesc
+		})).Return(links, nil)
+		mockRepo.On("CountLinksByUserID", ctx, userID, mock.Anything).Return(10, nil)
+		mockTagService.On("GetLinkTags", ctx, []string{"3", "2"}).
+			Return(map[string][]models.Tag{"2": {{ID: "t1", Name: "campaign"}}}, nil)
+		mockRepo.On("GetDestinationsByLinkIDs", ctx, []string{"3", "2"}).Return(map[string][]models.LinkDestination{}, nil)
+
+		page, err := service.GetLinksByUserID(ctx, userID, models.LinkQuery{Limit: 2})
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, 10, page.Total)
+		assert.NotEmpty(t, page.NextCursor)
+		assert.Empty(t, page.Items[0].Tags)
+		assert.Equal(t, []models.LinkTagResponse{{ID: "t1", Name: "campaign"}}, page.Items[1].Tags)
+
+		cursor, err := decodeLinkCursor(page.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, "2", cursor.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("when it is the last page, it should not return a next cursor", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockTagService := new(mocks.TagServiceMock)
+		service := &linkService{lr: mockRepo, ts: mockTagService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		links := []models.Link{
+			{ID: "1", OriginalURL: "https://example.com/1", ShortCode: "a", UserID: userID, CreatedAt: time.Now()},
+		}
+
+		mockRepo.On("GetLinksByUserID", ctx, userID, mock.Anything).Return(links, nil)
+		mockRepo.On("CountLinksByUserID", ctx, userID, mock.Anything).Return(1, nil)
+		mockTagService.On("GetLinkTags", ctx, []string{"1"}).Return(map[string][]models.Tag{}, nil)
+		mockRepo.On("GetDestinationsByLinkIDs", ctx, []string{"1"}).Return(map[string][]models.LinkDestination{}, nil)
+
+		page, err := service.GetLinksByUserID(ctx, userID, models.LinkQuery{})
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Items, 1)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("when the sort field is unknown, it should return ErrInvalidLinkQuery", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo}
+
+		_, err := service.GetLinksByUserID(context.Background(), uuid.New().String(), models.LinkQuery{Sort: "title"})
+
+		assert.Equal(t, models.ErrInvalidLinkQuery, err)
+		mockRepo.AssertNotCalled(t, "GetLinksByUserID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the cursor was issued for another sort, it should return ErrInvalidLinkCursor", func(t *testing.T) {
+		mockRepo := new(mocks.LinkRepositoryMock)
+		service := &linkService{lr: mockRepo}
+
+		cursor, err := encodeLinkCursor(models.LinkCursor{Sort: models.LinkSortClicks, Clicks: 5, ID: "1"})
+		assert.NoError(t, err)
+
+		_, err = service.GetLinksByUserID(context.Background(), uuid.New().String(), models.LinkQuery{Cursor: cursor})
+
+		assert.Equal(t, models.ErrInvalidLinkCursor, err)
+	})
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/g-villarinho/link-fizz-api/models"
@@ -12,15 +14,28 @@ import (
 )
 
 type LinkVisitService interface {
-	CreateLinkVisit(ctx context.Context, linkID, ipAddress, userAgent string) error
+	CreateLinkVisit(ctx context.Context, link *models.Link, request models.RedirectRequest) error
+	GetLinkStats(ctx context.Context, userID, domain, shortCode string, query models.LinkStatsQuery) (*models.LinkStatsResponse, error)
 }
 
 type linkVisitService struct {
 	i   *di.Injector
+	vr  VisitRecorder
+	lr  repositories.LinkRepository
 	lvr repositories.LinkVisitRepository
 }
 
 func NewLinkVisitService(i *di.Injector) (LinkVisitService, error) {
+	visitRecorder, err := di.Invoke[VisitRecorder](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.VisitRecorder: %w", err)
+	}
+
+	linkRepository, err := di.Invoke[repositories.LinkRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.LinkRepository: %w", err)
+	}
+
 	linkVisitRepository, err := di.Invoke[repositories.LinkVisitRepository](i)
 	if err != nil {
 		return nil, fmt.Errorf("invoke repositories.LinkVisitRepository: %w", err)
@@ -28,27 +43,176 @@ func NewLinkVisitService(i *di.Injector) (LinkVisitService, error) {
 
 	return &linkVisitService{
 		i:   i,
+		vr:  visitRecorder,
+		lr:  linkRepository,
 		lvr: linkVisitRepository,
 	}, nil
 }
 
-func (l *linkVisitService) CreateLinkVisit(ctx context.Context, linkID string, ipAddress string, userAgent string) error {
+func (l *linkVisitService) CreateLinkVisit(ctx context.Context, link *models.Link, request models.RedirectRequest) error {
+	logger := slog.With(
+		"service", "link_visit",
+		"method", "CreateLinkVisit",
+	)
+
 	id, err := uuid.NewRandom()
 	if err != nil {
 		return fmt.Errorf("generate uuid: %w", err)
 	}
 
 	linkVisit := &models.LinkVisit{
-		ID:        id.String(),
-		LinkID:    linkID,
-		IP:        ipAddress,
-		Agent:     userAgent,
-		VisitedAt: time.Now().UTC(),
+		ID:            id.String(),
+		LinkID:        link.ID,
+		DestinationID: toNullString(&request.DestinationID),
+		IP:            request.IPAddress,
+		Agent:         request.UserAgent,
+		Referrer:      toNullString(&request.Referrer),
+		Country:       toNullString(&request.Country),
+		Region:        toNullString(&request.Region),
+		City:          toNullString(&request.City),
+		VisitedAt:     time.Now().UTC(),
 	}
 
-	if err := l.lvr.CreateLinkVisit(ctx, linkVisit); err != nil {
-		return fmt.Errorf("create link visit: %w", err)
+	if link.MaxClicks.Valid {
+		if err := l.lr.RegisterVisit(ctx, linkVisit); err != nil {
+			return fmt.Errorf("register visit: %w", err)
+		}
+
+		return nil
+	}
+
+	if !l.vr.Enqueue(linkVisit) {
+		logger.Warn("visit queue full, visit dropped", "link_id", link.ID)
 	}
 
 	return nil
 }
+
+func (l *linkVisitService) GetLinkStats(ctx context.Context, userID string, domain string, shortCode string, query models.LinkStatsQuery) (*models.LinkStatsResponse, error) {
+	query, err := normalizeStatsQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := l.lr.GetLinkByShortCode(ctx, domain, shortCode)
+	if err != nil {
+		return nil, fmt.Errorf("get link by short code: %w", err)
+	}
+
+	if link == nil {
+		return nil, models.ErrLinkNotFound
+	}
+
+	if link.UserID != userID {
+		return nil, models.ErrLinkNotBelongToUser
+	}
+
+	summary, err := l.lvr.GetVisitSummary(ctx, link.ID, query.From, query.To)
+	if err != nil {
+		return nil, fmt.Errorf("get visit summary: %w", err)
+	}
+
+	timeline, err := l.lvr.GetVisitTimeline(ctx, link.ID, query.From, query.To, query.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("get visit timeline: %w", err)
+	}
+
+	topUserAgents, err := l.lvr.GetTopUserAgents(ctx, link.ID, query.From, query.To, models.TopStatsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get top user agents: %w", err)
+	}
+
+	topReferrers, err := l.lvr.GetTopReferrers(ctx, link.ID, query.From, query.To, models.TopStatsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get top referrers: %w", err)
+	}
+
+	topCountries, err := l.lvr.GetTopCountries(ctx, link.ID, query.From, query.To, models.TopStatsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get top countries: %w", err)
+	}
+
+	topCities, err := l.lvr.GetTopCities(ctx, link.ID, query.From, query.To, models.TopStatsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get top cities: %w", err)
+	}
+
+	destinations, err := l.getDestinationClicks(ctx, link, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.LinkStatsResponse{
+		ShortCode:      link.ShortCode,
+		From:           query.From,
+		To:             query.To,
+		Bucket:         query.Bucket,
+		TotalClicks:    summary.TotalClicks,
+		UniqueVisitors: summary.UniqueVisitors,
+		Timeline:       timeline,
+		TopUserAgents:  topUserAgents,
+		TopReferrers:   topReferrers,
+		TopCountries:   topCountries,
+		TopCities:      topCities,
+		Destinations:   destinations,
+	}, nil
+}
+
+func (l *linkVisitService) getDestinationClicks(ctx context.Context, link *models.Link, query models.LinkStatsQuery) ([]models.DestinationClicks, error) {
+	clicks, err := l.lvr.GetDestinationClicks(ctx, link.ID, query.From, query.To)
+	if err != nil {
+		return nil, fmt.Errorf("get destination clicks: %w", err)
+	}
+
+	destinations := make([]models.DestinationClicks, 0, len(link.Destinations)+len(clicks))
+	for _, destination := range link.Destinations {
+		response := destination.ToResponse()
+		destinations = append(destinations, models.DestinationClicks{
+			ID:     response.ID,
+			URL:    response.URL,
+			Label:  response.Label,
+			Weight: response.Weight,
+			Clicks: clicks[destination.ID],
+		})
+		delete(clicks, destination.ID)
+	}
+
+	removedIDs := make([]string, 0, len(clicks))
+	for id := range clicks {
+		removedIDs = append(removedIDs, id)
+	}
+	slices.Sort(removedIDs)
+
+	for _, id := range removedIDs {
+		destinations = append(destinations, models.DestinationClicks{ID: id, Clicks: clicks[id]})
+	}
+
+	return destinations, nil
+}
+
+func normalizeStatsQuery(query models.LinkStatsQuery) (models.LinkStatsQuery, error) {
+	if query.To.IsZero() {
+		query.To = time.Now().UTC()
+	}
+
+	if query.From.IsZero() {
+		query.From = query.To.Add(-models.DefaultStatsRange)
+	}
+
+	if !query.From.Before(query.To) {
+		return query, models.ErrInvalidStatsQuery
+	}
+
+	switch query.Bucket {
+	case "":
+		query.Bucket = models.StatsBucketDay
+	case models.StatsBucketHour, models.StatsBucketDay, models.StatsBucketWeek:
+	default:
+		return query, models.ErrInvalidStatsQuery
+	}
+
+	query.From = query.From.UTC()
+	query.To = query.To.UTC()
+
+	return query, nil
+}
@@ -0,0 +1,195 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetLinkStats(t *testing.T) {
+	t.Run("when the link belongs to the user, it should aggregate the stats", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockVisitRepo := new(mocks.LinkVisitRepositoryMock)
+		service := &linkVisitService{
+			lr:  mockLinkRepo,
+			lvr: mockVisitRepo,
+		}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: userID}
+		to := time.Now().UTC()
+		from := to.Add(-24 * time.Hour)
+		timeline := []models.VisitBucket{{Start: from, Clicks: 3}}
+		agents := []models.VisitCount{{Value: "curl", Clicks: 3}}
+		referrers := []models.VisitCount{{Value: "https://news.example.com", Clicks: 2}}
+		countries := []models.VisitCount{{Value: "BR", Clicks: 3}}
+		cities := []models.VisitLocation{{Country: "BR", Region: "Sao Paulo", City: "Campinas", Clicks: 2}}
+
+		mockLinkRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockVisitRepo.On("GetVisitSummary", ctx, link.ID, from, to).Return(&models.VisitSummary{TotalClicks: 3, UniqueVisitors: 2}, nil)
+		mockVisitRepo.On("GetVisitTimeline", ctx, link.ID, from, to, models.StatsBucketHour).Return(timeline, nil)
+		mockVisitRepo.On("GetTopUserAgents", ctx, link.ID, from, to, models.TopStatsLimit).Return(agents, nil)
+		mockVisitRepo.On("GetTopReferrers", ctx, link.ID, from, to, models.TopStatsLimit).Return(referrers, nil)
+		mockVisitRepo.On("GetTopCountries", ctx, link.ID, from, to, models.TopStatsLimit).Return(countries, nil)
+		mockVisitRepo.On("GetTopCities", ctx, link.ID, from, to, models.TopStatsLimit).Return(cities, nil)
+		mockVisitRepo.On("GetDestinationClicks", ctx, link.ID, from, to).Return(map[string]int{}, nil)
+
+		stats, err := service.GetLinkStats(ctx, userID, "", link.ShortCode, models.LinkStatsQuery{From: from, To: to, Bucket: models.StatsBucketHour})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, stats.TotalClicks)
+		assert.Equal(t, 2, stats.UniqueVisitors)
+		assert.Equal(t, timeline, stats.Timeline)
+		assert.Equal(t, agents, stats.TopUserAgents)
+		assert.Equal(t, referrers, stats.TopReferrers)
+		assert.Equal(t, countries, stats.TopCountries)
+		assert.Equal(t, cities, stats.TopCities)
+		assert.Empty(t, stats.Destinations)
+		mockVisitRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the link rotates destinations, it should report clicks per destination", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockVisitRepo := new(mocks.LinkVisitRepositoryMock)
+		service := &linkVisitService{
+			lr:  mockLinkRepo,
+			lvr: mockVisitRepo,
+		}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		link := &models.Link{
+			ID:        uuid.New().String(),
+			ShortCode: "abcd1234",
+			UserID:    userID,
+			Destinations: []models.LinkDestination{
+				{ID: "a", URL: "https://example.com/a", Label: sql.NullString{String: "control", Valid: true}, Weight: 70},
+				{ID: "b", URL: "https://example.com/b", Weight: 30},
+			},
+		}
+
+		mockLinkRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockVisitRepo.On("GetVisitSummary", ctx, link.ID, mock.Anything, mock.Anything).Return(&models.VisitSummary{TotalClicks: 10}, nil)
+		mockVisitRepo.On("GetVisitTimeline", ctx, link.ID, mock.Anything, mock.Anything, models.StatsBucketDay).Return([]models.VisitBucket{}, nil)
+		mockVisitRepo.On("GetTopUserAgents", ctx, link.ID, mock.Anything, mock.Anything, models.TopStatsLimit).Return([]models.VisitCount{}, nil)
+		mockVisitRepo.On("GetTopReferrers", ctx, link.ID, mock.Anything, mock.Anything, models.TopStatsLimit).Return([]models.VisitCount{}, nil)
+		mockVisitRepo.On("GetTopCountries", ctx, link.ID, mock.Anything, mock.Anything, models.TopStatsLimit).Return([]models.VisitCount{}, nil)
+		mockVisitRepo.On("GetTopCities", ctx, link.ID, mock.Anything, mock.Anything, models.TopStatsLimit).Return([]models.VisitLocation{}, nil)
+		mockVisitRepo.On("GetDestinationClicks", ctx, link.ID, mock.Anything, mock.Anything).Return(map[string]int{"a": 6, "removed": 4}, nil)
+
+		stats, err := service.GetLinkStats(ctx, userID, "", link.ShortCode, models.LinkStatsQuery{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.DestinationClicks{
+			{ID: "a", URL: "https://example.com/a", Label: "control", Weight: 70, Clicks: 6},
+			{ID: "b", URL: "https://example.com/b", Weight: 30, Clicks: 0},
+			{ID: "removed", Clicks: 4},
+		}, stats.Destinations)
+	})
+
+	t.Run("when the link belongs to another user, it should return ErrLinkNotBelongToUser", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockVisitRepo := new(mocks.LinkVisitRepositoryMock)
+		service := &linkVisitService{
+			lr:  mockLinkRepo,
+			lvr: mockVisitRepo,
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), ShortCode: "abcd1234", UserID: uuid.New().String()}
+
+		mockLinkRepo.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+
+		_, err := service.GetLinkStats(ctx, uuid.New().String(), "", link.ShortCode, models.LinkStatsQuery{})
+
+		assert.Equal(t, models.ErrLinkNotBelongToUser, err)
+		mockVisitRepo.AssertNotCalled(t, "GetVisitSummary", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the bucket is unknown, it should return ErrInvalidStatsQuery", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		service := &linkVisitService{lr: mockLinkRepo}
+
+		_, err := service.GetLinkStats(context.Background(), uuid.New().String(), "", "abcd1234", models.LinkStatsQuery{Bucket: "month"})
+
+		assert.Equal(t, models.ErrInvalidStatsQuery, err)
+		mockLinkRepo.AssertNotCalled(t, "GetLinkByShortCode", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when from is after to, it should return ErrInvalidStatsQuery", func(t *testing.T) {
+		service := &linkVisitService{}
+		to := time.Now().UTC()
+
+		_, err := service.GetLinkStats(context.Background(), uuid.New().String(), "", "abcd1234", models.LinkStatsQuery{From: to.Add(time.Hour), To: to})
+
+		assert.Equal(t, models.ErrInvalidStatsQuery, err)
+	})
+}
+
+func TestCreateLinkVisit(t *testing.T) {
+	t.Run("when the link has a click limit, it should register the visit synchronously", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockRecorder := new(mocks.VisitRecorderMock)
+		service := &linkVisitService{
+			lr: mockLinkRepo,
+			vr: mockRecorder,
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), MaxClicks: sql.NullInt64{Int64: 1, Valid: true}}
+
+		mockLinkRepo.On("RegisterVisit", ctx, mock.AnythingOfType("*models.LinkVisit")).Return(nil)
+
+		err := service.CreateLinkVisit(ctx, link, models.RedirectRequest{IPAddress: "127.0.0.1"})
+
+		assert.NoError(t, err)
+		mockLinkRepo.AssertExpectations(t)
+		mockRecorder.AssertNotCalled(t, "Enqueue", mock.Anything)
+	})
+
+	t.Run("when the link has no click limit, it should enqueue the visit", func(t *testing.T) {
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockRecorder := new(mocks.VisitRecorderMock)
+		service := &linkVisitService{
+			lr: mockLinkRepo,
+			vr: mockRecorder,
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String()}
+
+		mockRecorder.On("Enqueue", mock.MatchedBy(func(v *models.LinkVisit) bool {
+			return v.LinkID == link.ID
+		})).Return(true)
+
+		err := service.CreateLinkVisit(ctx, link, models.RedirectRequest{IPAddress: "127.0.0.1"})
+
+		assert.NoError(t, err)
+		mockRecorder.AssertExpectations(t)
+		mockLinkRepo.AssertNotCalled(t, "RegisterVisit", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the queue is full, it should drop the visit without failing the redirect", func(t *testing.T) {
+		mockRecorder := new(mocks.VisitRecorderMock)
+		service := &linkVisitService{
+			vr: mockRecorder,
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String()}
+
+		mockRecorder.On("Enqueue", mock.AnythingOfType("*models.LinkVisit")).Return(false)
+
+		err := service.CreateLinkVisit(ctx, link, models.RedirectRequest{})
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,219 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/google/uuid"
+)
+
+type Mailer interface {
+	Send(ctx context.Context, email models.Email) error
+}
+
+type smtpMailer struct {
+	cfg models.Mailer
+}
+
+type outboxMailer struct {
+	cfg models.Mailer
+}
+
+type memoryMailer struct {
+	mu     sync.Mutex
+	emails []models.Email
+}
+
+func NewMailer(i *di.Injector) (Mailer, error) {
+	return newMailer(config.Env.Mailer)
+}
+
+func newMailer(cfg models.Mailer) (Mailer, error) {
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return nil, fmt.Errorf("parse sender address: %w", err)
+	}
+
+	switch cfg.Driver {
+	case models.MailerDriverSMTP:
+		return &smtpMailer{cfg: cfg}, nil
+	case models.MailerDriverOutbox:
+		if err := os.MkdirAll(cfg.OutboxDir, 0o755); err != nil {
+			return nil, fmt.Errorf("create outbox directory: %w", err)
+		}
+		return &outboxMailer{cfg: cfg}, nil
+	case models.MailerDriverMemory:
+		return newMemoryMailer(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", models.ErrUnknownMailerDriver, cfg.Driver)
+	}
+}
+
+func newMemoryMailer() *memoryMailer {
+	return &memoryMailer{}
+}
+
+func (s *smtpMailer) Send(ctx context.Context, email models.Email) error {
+	from, err := mail.ParseAddress(s.cfg.From)
+	if err != nil {
+		return fmt.Errorf("parse sender address: %w", err)
+	}
+
+	to, err := mail.ParseAddress(email.To)
+	if err != nil {
+		return fmt.Errorf("parse recipient address: %w", err)
+	}
+
+	message := buildEmailMessage(s.cfg.From, email, time.Now())
+
+	var auth smtp.Auth
+	if s.cfg.SMTP.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.SMTP.Username, s.cfg.SMTP.Password, s.cfg.SMTP.Host)
+	}
+
+	if err := s.deliver(ctx, auth, from.Address, to.Address, message); err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+
+	return nil
+}
+
+// deliver follows smtp.SendMail, but dials through ctx and bounds the whole
+// conversation with a deadline so a stalled server cannot hold the request.
+func (s *smtpMailer) deliver(ctx context.Context, auth smtp.Auth, from, to string, message []byte) error {
+	if s.cfg.SMTP.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.cfg.SMTP.Timeout)
+		defer cancel()
+	}
+
+	address := net.JoinHostPort(s.cfg.SMTP.Host, strconv.Itoa(s.cfg.SMTP.Port))
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return fmt.Errorf("set deadline: %w", err)
+		}
+	}
+
+	// Cancelling ctx unblocks any read or write in progress.
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	client, err := smtp.NewClient(conn, s.cfg.SMTP.Host)
+	if err != nil {
+		return fmt.Errorf("new client: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.cfg.SMTP.Host}); err != nil {
+			return fmt.Errorf("start tls: %w", err)
+		}
+	}
+
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("server does not support AUTH")
+		}
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("rcpt: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("data: %w", err)
+	}
+
+	if _, err := writer.Write(message); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("close message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+func (o *outboxMailer) Send(ctx context.Context, email models.Email) error {
+	if _, err := mail.ParseAddress(email.To); err != nil {
+		return fmt.Errorf("parse recipient address: %w", err)
+	}
+
+	now := time.Now().UTC()
+	filename := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405.000000000Z"), uuid.NewString())
+
+	if err := os.WriteFile(filepath.Join(o.cfg.OutboxDir, filename), buildEmailMessage(o.cfg.From, email, now), 0o644); err != nil {
+		return fmt.Errorf("write outbox message: %w", err)
+	}
+
+	return nil
+}
+
+func (m *memoryMailer) Send(ctx context.Context, email models.Email) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.emails = append(m.emails, email)
+
+	return nil
+}
+
+func (m *memoryMailer) Emails() []models.Email {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]models.Email(nil), m.emails...)
+}
+
+func buildEmailMessage(from string, email models.Email, sentAt time.Time) []byte {
+	var message bytes.Buffer
+
+	fmt.Fprintf(&message, "From: %s\r\n", stripHeaderBreaks(from))
+	fmt.Fprintf(&message, "To: %s\r\n", stripHeaderBreaks(email.To))
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", stripHeaderBreaks(email.Subject)))
+	fmt.Fprintf(&message, "Date: %s\r\n", sentAt.Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	message.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	message.WriteString("\r\n")
+	message.WriteString(strings.ReplaceAll(strings.ReplaceAll(email.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return message.Bytes()
+}
+
+func stripHeaderBreaks(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
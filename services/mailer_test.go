@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMailer(t *testing.T) {
+	t.Run("when the driver is unknown, it should return ErrUnknownMailerDriver", func(t *testing.T) {
+		_, err := newMailer(models.Mailer{Driver: "carrier-pigeon", From: "no-reply@example.com"})
+
+		assert.ErrorIs(t, err, models.ErrUnknownMailerDriver)
+	})
+
+	t.Run("when the sender address is invalid, it should return an error", func(t *testing.T) {
+		_, err := newMailer(models.Mailer{Driver: models.MailerDriverMemory, From: "not an address"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestOutboxMailer(t *testing.T) {
+	t.Run("when an email is sent, it should write it to the outbox directory", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "outbox")
+		mailer, err := newMailer(models.Mailer{Driver: models.MailerDriverOutbox, From: "Link Fizz <no-reply@example.com>", OutboxDir: dir})
+		assert.NoError(t, err)
+
+		err = mailer.Send(context.Background(), models.Email{To: "ana@example.com", Subject: "Olá\r\nBcc: spam@example.com", Body: "line one\nline two\n"})
+		assert.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+
+		content, err := os.ReadFile(files[0])
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "To: ana@example.com\r\n")
+		assert.NotContains(t, string(content), "\r\nBcc:")
+		assert.Contains(t, string(content), "\r\n\r\nline one\r\nline two\r\n")
+	})
+
+	t.Run("when the recipient is invalid, it should not write a message", func(t *testing.T) {
+		dir := t.TempDir()
+		mailer, err := newMailer(models.Mailer{Driver: models.MailerDriverOutbox, From: "no-reply@example.com", OutboxDir: dir})
+		assert.NoError(t, err)
+
+		err = mailer.Send(context.Background(), models.Email{To: "not an address"})
+
+		assert.Error(t, err)
+		entries, _ := os.ReadDir(dir)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestSMTPMailer(t *testing.T) {
+	newStalledServer := func(t *testing.T) *net.TCPAddr {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		t.Cleanup(func() { listener.Close() })
+
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				// Never greet, and drop the connection once the client hangs up.
+				go func() {
+					defer conn.Close()
+					io.Copy(io.Discard, conn)
+				}()
+			}
+		}()
+
+		return listener.Addr().(*net.TCPAddr)
+	}
+
+	newSMTPMailer := func(t *testing.T, addr *net.TCPAddr, timeout time.Duration) Mailer {
+		mailer, err := newMailer(models.Mailer{
+			Driver: models.MailerDriverSMTP,
+			From:   "no-reply@example.com",
+			SMTP:   models.SMTP{Host: addr.IP.String(), Port: addr.Port, Timeout: timeout},
+		})
+		assert.NoError(t, err)
+		return mailer
+	}
+
+	email := models.Email{To: "user@example.com", Subject: "Hi", Body: "Hello"}
+
+	t.Run("when the server stalls, it should give up after the timeout", func(t *testing.T) {
+		mailer := newSMTPMailer(t, newStalledServer(t), 100*time.Millisecond)
+
+		start := time.Now()
+		err := mailer.Send(context.Background(), email)
+
+		assert.Error(t, err)
+		assert.Less(t, time.Since(start), 2*time.Second)
+	})
+
+	t.Run("when the context is cancelled, it should stop waiting on the server", func(t *testing.T) {
+		mailer := newSMTPMailer(t, newStalledServer(t), time.Minute)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+
+		start := time.Now()
+		err := mailer.Send(ctx, email)
+
+		assert.Error(t, err)
+		assert.Less(t, time.Since(start), 2*time.Second)
+	})
+}
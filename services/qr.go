@@ -0,0 +1,215 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+var qrLevels = map[models.QRLevel]qrcode.RecoveryLevel{
+	models.QRLevelLow:     qrcode.Low,
+	models.QRLevelMedium:  qrcode.Medium,
+	models.QRLevelQuality: qrcode.High,
+	models.QRLevelHigh:    qrcode.Highest,
+}
+
+type QRService interface {
+	GenerateLinkQRCode(ctx context.Context, userID, domain, shortCode string, options models.QROptions) (*models.QRCode, error)
+}
+
+type qrService struct {
+	i  *di.Injector
+	ls LinkService
+}
+
+func NewQRService(i *di.Injector) (QRService, error) {
+	linkService, err := di.Invoke[LinkService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.LinkService: %w", err)
+	}
+
+	return &qrService{
+		i:  i,
+		ls: linkService,
+	}, nil
+}
+
+func (q *qrService) GenerateLinkQRCode(ctx context.Context, userID, domain, shortCode string, options models.QROptions) (*models.QRCode, error) {
+	options, err := normalizeQROptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	foreground, err := parseHexColor(options.Foreground)
+	if err != nil {
+		return nil, models.ErrInvalidQROptions
+	}
+
+	background, err := parseHexColor(options.Background)
+	if err != nil {
+		return nil, models.ErrInvalidQROptions
+	}
+
+	link, err := q.ls.GetLinkDetails(ctx, userID, domain, shortCode)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := qrcode.New(link.ShortURL, qrLevels[options.Level])
+	if err != nil {
+		return nil, fmt.Errorf("encode QR code: %w", err)
+	}
+	code.DisableBorder = true
+
+	bitmap := code.Bitmap()
+	modules := len(bitmap) + 2**options.Margin
+	if options.Size < modules {
+		return nil, models.ErrInvalidQROptions
+	}
+
+	if options.Format == models.QRFormatSVG {
+		return &models.QRCode{
+			ContentType: "image/svg+xml",
+			Data:        renderQRSVG(bitmap, *options.Margin, options.Size, options.Foreground, options.Background),
+		}, nil
+	}
+
+	data, err := renderQRPNG(bitmap, *options.Margin, options.Size, foreground, background)
+	if err != nil {
+		return nil, fmt.Errorf("render QR code: %w", err)
+	}
+
+	return &models.QRCode{
+		ContentType: "image/png",
+		Data:        data,
+	}, nil
+}
+
+func normalizeQROptions(options models.QROptions) (models.QROptions, error) {
+	if options.Format == "" {
+		options.Format = models.QRFormatPNG
+	}
+
+	if options.Format != models.QRFormatPNG && options.Format != models.QRFormatSVG {
+		return options, models.ErrInvalidQROptions
+	}
+
+	if options.Size == 0 {
+		options.Size = models.DefaultQRSize
+	}
+
+	if options.Size < 0 || options.Size > models.MaxQRSize {
+		return options, models.ErrInvalidQROptions
+	}
+
+	if options.Margin == nil {
+		margin := models.DefaultQRMargin
+		options.Margin = &margin
+	}
+
+	if *options.Margin < 0 || *options.Margin > models.MaxQRMargin {
+		return options, models.ErrInvalidQROptions
+	}
+
+	options.Level = models.QRLevel(strings.ToUpper(string(options.Level)))
+	if options.Level == "" {
+		options.Level = models.QRLevelMedium
+	}
+
+	if _, ok := qrLevels[options.Level]; !ok {
+		return options, models.ErrInvalidQROptions
+	}
+
+	if options.Foreground == "" {
+		options.Foreground = models.DefaultQRColor
+	}
+
+	if options.Background == "" {
+		options.Background = models.DefaultQRBgColor
+	}
+
+	options.Foreground = strings.ToLower(strings.TrimPrefix(options.Foreground, "#"))
+	options.Background = strings.ToLower(strings.TrimPrefix(options.Background, "#"))
+
+	return options, nil
+}
+
+func parseHexColor(value string) (color.RGBA, error) {
+	if len(value) == 3 {
+		value = string([]byte{value[0], value[0], value[1], value[1], value[2], value[2]})
+	}
+
+	if len(value) != 6 {
+		return color.RGBA{}, fmt.Errorf("invalid color %q", value)
+	}
+
+	rgb, err := hex.DecodeString(value)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("invalid color %q: %w", value, err)
+	}
+
+	return color.RGBA{R: rgb[0], G: rgb[1], B: rgb[2], A: 0xff}, nil
+}
+
+func qrLayout(modules, margin, size int) (scale, offset int) {
+	total := modules + 2*margin
+	scale = size / total
+	offset = (size-total*scale)/2 + margin*scale
+	return scale, offset
+}
+
+func renderQRPNG(bitmap [][]bool, margin, size int, foreground, background color.RGBA) ([]byte, error) {
+	scale, offset := qrLayout(len(bitmap), margin, size)
+
+	img := image.NewPaletted(image.Rect(0, 0, size, size), color.Palette{background, foreground})
+
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if !dark {
+				continue
+			}
+
+			for dy := range scale {
+				for dx := range scale {
+					img.SetColorIndex(offset+x*scale+dx, offset+y*scale+dy, 1)
+				}
+			}
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func renderQRSVG(bitmap [][]bool, margin, size int, foreground, background string) []byte {
+	total := len(bitmap) + 2*margin
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, total, total)
+	fmt.Fprintf(&buffer, `<rect width="%d" height="%d" fill="#%s"/>`, total, total, background)
+	fmt.Fprintf(&buffer, `<path fill="#%s" d="`, foreground)
+
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&buffer, "M%d %dh1v1h-1z", x+margin, y+margin)
+			}
+		}
+	}
+
+	buffer.WriteString(`"/></svg>`)
+	return buffer.Bytes()
+}
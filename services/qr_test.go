@@ -0,0 +1,125 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateLinkQRCode(t *testing.T) {
+	t.Run("when the format is png, it should render an image of the requested size", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		service := &qrService{ls: mockLinkService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		margin := 0
+
+		mockLinkService.On("GetLinkDetails", ctx, userID, "", "abcd1234").
+			Return(&models.LinkResponse{ShortURL: "http://localhost:8080/abcd1234"}, nil)
+
+		qrCode, err := service.GenerateLinkQRCode(ctx, userID, "", "abcd1234", models.QROptions{
+			Size:       300,
+			Margin:     &margin,
+			Foreground: "#ff0000",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "image/png", qrCode.ContentType)
+
+		img, err := png.Decode(bytes.NewReader(qrCode.Data))
+		assert.NoError(t, err)
+		assert.Equal(t, 300, img.Bounds().Dx())
+		assert.Equal(t, 300, img.Bounds().Dy())
+
+		r, g, b, _ := img.At(12, 12).RGBA()
+		assert.Equal(t, color.RGBA{R: 0xff}, color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8)})
+	})
+
+	t.Run("when the format is svg, it should render the colors and the quiet zone", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		service := &qrService{ls: mockLinkService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockLinkService.On("GetLinkDetails", ctx, userID, "", "abcd1234").
+			Return(&models.LinkResponse{ShortURL: "http://localhost:8080/abcd1234"}, nil)
+
+		qrCode, err := service.GenerateLinkQRCode(ctx, userID, "", "abcd1234", models.QROptions{
+			Format:     models.QRFormatSVG,
+			Level:      "h",
+			Background: "FFEEDD",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "image/svg+xml", qrCode.ContentType)
+		svg := string(qrCode.Data)
+		assert.True(t, strings.HasPrefix(svg, "<svg"))
+		assert.Contains(t, svg, `width="256"`)
+		assert.Contains(t, svg, `fill="#ffeedd"`)
+		assert.Contains(t, svg, `fill="#000000"`)
+		assert.Contains(t, svg, "M4 4h1v1h-1z")
+	})
+
+	t.Run("when the options are invalid, it should return ErrInvalidQROptions", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		service := &qrService{ls: mockLinkService}
+
+		ctx := context.Background()
+		margin := -1
+
+		cases := []models.QROptions{
+			{Format: "gif"},
+			{Size: models.MaxQRSize + 1},
+			{Margin: &margin},
+			{Level: "X"},
+			{Foreground: "zzzzzz"},
+			{Background: "12345"},
+		}
+
+		for _, options := range cases {
+			_, err := service.GenerateLinkQRCode(ctx, uuid.New().String(), "", "abcd1234", options)
+			assert.Equal(t, models.ErrInvalidQROptions, err)
+		}
+
+		mockLinkService.AssertNotCalled(t, "GetLinkDetails")
+	})
+
+	t.Run("when the size cannot fit the code, it should return ErrInvalidQROptions", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		service := &qrService{ls: mockLinkService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockLinkService.On("GetLinkDetails", ctx, userID, "", "abcd1234").
+			Return(&models.LinkResponse{ShortURL: "http://localhost:8080/abcd1234"}, nil)
+
+		_, err := service.GenerateLinkQRCode(ctx, userID, "", "abcd1234", models.QROptions{Size: 10})
+
+		assert.Equal(t, models.ErrInvalidQROptions, err)
+	})
+
+	t.Run("when the link belongs to another user, it should return ErrLinkNotBelongToUser", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		service := &qrService{ls: mockLinkService}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockLinkService.On("GetLinkDetails", ctx, userID, "", "abcd1234").Return(nil, models.ErrLinkNotBelongToUser)
+
+		_, err := service.GenerateLinkQRCode(ctx, userID, "", "abcd1234", models.QROptions{})
+
+		assert.Equal(t, models.ErrLinkNotBelongToUser, err)
+	})
+}
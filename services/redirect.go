@@ -2,20 +2,39 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/g-villarinho/link-fizz-api/models"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
 )
 
+const (
+	linkAccessTokenDuration = 30 * time.Minute
+	unknownCountryCode      = "XX"
+)
+
 type RedirectService interface {
-	GetOriginalURLWithTracking(ctx context.Context, linkShortCode, userAgent, ipAddress string) (string, error)
+	GetOriginalURLWithTracking(ctx context.Context, request models.RedirectRequest) (*models.RedirectResponse, error)
+	UnlockLink(ctx context.Context, request models.RedirectRequest, password string) (*models.UnlockLinkResponse, error)
 }
 
 type redirectService struct {
 	i   *di.Injector
 	ls  LinkService
 	lvs LinkVisitService
+	ss  SecurityService
+	ts  TokenService
+	lc  LinkCache
+	gl  GeoIPLocator
+	ul  UnlockLimiter
+
+	defaultHost string
 }
 
 func NewRedirectService(i *di.Injector) (RedirectService, error) {
@@ -29,30 +48,305 @@ func NewRedirectService(i *di.Injector) (RedirectService, error) {
 		return nil, fmt.Errorf("invoke services.LinkVisit: %w", err)
 	}
 
+	securityService, err := di.Invoke[SecurityService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.Security: %w", err)
+	}
+
+	tokenService, err := di.Invoke[TokenService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.Token: %w", err)
+	}
+
+	linkCache, err := di.Invoke[LinkCache](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.LinkCache: %w", err)
+	}
+
+	geoIPLocator, err := di.Invoke[GeoIPLocator](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.GeoIPLocator: %w", err)
+	}
+
+	unlockLimiter, err := di.Invoke[UnlockLimiter](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.UnlockLimiter: %w", err)
+	}
+
 	return &redirectService{
 		i:   i,
 		ls:  linkService,
 		lvs: linkVisitService,
+		ss:  securityService,
+		ts:  tokenService,
+		lc:  linkCache,
+		gl:  geoIPLocator,
+		ul:  unlockLimiter,
+
+		defaultHost: defaultHostname(),
 	}, nil
 }
 
-func (r *redirectService) GetOriginalURLWithTracking(ctx context.Context, linkShortCode string, userAgent string, ipAddress string) (string, error) {
+func (r *redirectService) GetOriginalURLWithTracking(ctx context.Context, request models.RedirectRequest) (*models.RedirectResponse, error) {
+	link, err := r.getLink(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("get original URL by short code: %w", err)
+	}
+
+	if err := checkAvailability(link); err != nil {
+		return fallbackOrError(link, err)
+	}
+
+	if link.IsPasswordProtected() {
+		if request.AccessToken == "" {
+			return nil, models.ErrLinkPasswordRequired
+		}
+
+		if err := r.ts.ValidateLinkAccessToken(ctx, request.AccessToken, link.ID); err != nil {
+			return nil, models.ErrLinkPasswordRequired
+		}
+	}
+
+	return r.trackVisit(ctx, link, request)
+}
+
+func (r *redirectService) UnlockLink(ctx context.Context, request models.RedirectRequest, password string) (*models.UnlockLinkResponse, error) {
+	link, err := r.getLink(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("get link by short code: %w", err)
+	}
+
+	if err := checkAvailability(link); err != nil {
+		fallback, err := fallbackOrError(link, err)
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.UnlockLinkResponse{DestinationURL: fallback.DestinationURL, StatusCode: unlockStatusCode(fallback.StatusCode)}, nil
+	}
+
+	response := &models.UnlockLinkResponse{}
+
+	if link.IsPasswordProtected() {
+		if !r.ul.Reserve(link.ID, request.IPAddress) {
+			return nil, models.ErrTooManyUnlockAttempts
+		}
+
+		if err := r.ss.VerifyPassword(ctx, link.PasswordHash.String, password); err != nil {
+			return nil, models.ErrInvalidLinkPassword
+		}
+
+		r.ul.Reset(link.ID, request.IPAddress)
+
+		now := time.Now().UTC()
+		expiresAt := now.Add(linkAccessTokenDuration)
+
+		accessToken, err := r.ts.GenerateLinkAccessToken(ctx, link.ID, now, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("generate link access token: %w", err)
+		}
+
+		response.AccessToken = accessToken
+		response.ExpiresAt = expiresAt
+	}
+
+	redirect, err := r.trackVisit(ctx, link, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response.DestinationURL = redirect.DestinationURL
+	response.StatusCode = unlockStatusCode(redirect.StatusCode)
+	response.DestinationID = redirect.DestinationID
+	response.StickyDestination = redirect.StickyDestination
+	return response, nil
+}
+
+func (r *redirectService) getLink(ctx context.Context, request models.RedirectRequest) (*models.Link, error) {
+	domain := models.HostDomainKey(request.Host, r.defaultHost)
+	link, err := r.getCachedLink(ctx, domain, request.ShortCode)
+
+	// Without a configured default host, any host not registered as a custom
+	// domain is treated as the default domain.
+	if errors.Is(err, models.ErrLinkNotFound) && r.defaultHost == "" && domain != "" {
+		return r.getCachedLink(ctx, "", request.ShortCode)
+	}
+
+	return link, err
+}
+
+func (r *redirectService) getCachedLink(ctx context.Context, domain, shortCode string) (*models.Link, error) {
+	if link, hit := r.lc.Get(domain, shortCode); hit {
+		if link == nil {
+			return nil, models.ErrLinkNotFound
+		}
+
+		return link, nil
+	}
+
+	link, err := r.ls.GetLinkByShortCode(ctx, domain, shortCode)
+	if err != nil {
+		if errors.Is(err, models.ErrLinkNotFound) {
+			r.lc.SetNotFound(domain, shortCode)
+		}
+
+		return nil, err
+	}
+
+	r.lc.Set(domain, shortCode, link)
+	return link, nil
+}
+
+func (r *redirectService) trackVisit(ctx context.Context, link *models.Link, request models.RedirectRequest) (*models.RedirectResponse, error) {
 	logger := slog.With(
 		"service", "redirect",
-		"method", "GetOriginalURLWithTracking",
+		"method", "trackVisit",
 	)
 
-	link, err := r.ls.GetLinkByShortCode(ctx, linkShortCode)
-	if err != nil {
-		return "", fmt.Errorf("get original URL by short code: %w", err)
+	request = r.locateVisitor(request)
+	rule := matchLinkRule(link.Rules, newRuleVisitor(request, time.Now().UTC()))
+
+	var destination *models.LinkDestination
+	if rule == nil {
+		destination = pickDestination(link, request.DestinationID)
 	}
 
-	go func() {
-		if err = r.lvs.CreateLinkVisit(context.Background(), link.ID, ipAddress, userAgent); err != nil {
-			logger.Error("create link visit", "error", err)
-			return
+	request.DestinationID = ""
+	if destination != nil {
+		request.DestinationID = destination.ID
+	}
+
+	if err := r.lvs.CreateLinkVisit(ctx, link, request); err != nil {
+		if errors.Is(err, models.ErrLinkClickLimitReached) {
+			r.lc.Invalidate(link.DomainKey(), link.ShortCode)
+			return fallbackOrError(link, models.ErrLinkClickLimitReached)
+		}
+
+		if link.MaxClicks.Valid {
+			return nil, fmt.Errorf("create link visit: %w", err)
 		}
-	}()
 
-	return link.OriginalURL, nil
+		logger.Error("create link visit", "error", err)
+	}
+
+	redirectType := link.RedirectType
+	if !models.IsValidRedirectType(redirectType) {
+		redirectType = models.DefaultRedirectType
+	}
+
+	response := &models.RedirectResponse{
+		DestinationURL: link.OriginalURL,
+		StatusCode:     redirectType,
+		Cacheable:      link.IsPubliclyCacheable(),
+	}
+
+	if rule != nil {
+		response.DestinationURL = rule.DestinationURL
+	}
+
+	if destination != nil {
+		response.DestinationURL = destination.URL
+		response.DestinationID = destination.ID
+		response.StickyDestination = link.StickyDestinations
+	}
+
+	return response, nil
+}
+
+func (r *redirectService) locateVisitor(request models.RedirectRequest) models.RedirectRequest {
+	location := r.gl.Lookup(request.IPAddress)
+
+	country := strings.ToUpper(strings.TrimSpace(request.Country))
+	if !countryCodePattern.MatchString(country) || country == unknownCountryCode {
+		country = location.Country
+	}
+
+	request.Country = country
+	request.Region = ""
+	request.City = ""
+
+	if country != "" && country == location.Country {
+		request.Region = location.Region
+		request.City = location.City
+	}
+
+	return request
+}
+
+func pickDestination(link *models.Link, stickyID string) *models.LinkDestination {
+	if len(link.Destinations) == 0 {
+		return nil
+	}
+
+	totalWeight := 0
+	for i, destination := range link.Destinations {
+		if link.StickyDestinations && stickyID != "" && destination.ID == stickyID {
+			return &link.Destinations[i]
+		}
+		totalWeight += destination.Weight
+	}
+
+	if totalWeight <= 0 {
+		return &link.Destinations[0]
+	}
+
+	pick := rand.IntN(totalWeight)
+	for i, destination := range link.Destinations {
+		if pick < destination.Weight {
+			return &link.Destinations[i]
+		}
+		pick -= destination.Weight
+	}
+
+	return &link.Destinations[len(link.Destinations)-1]
+}
+
+func checkAvailability(link *models.Link) error {
+	now := time.Now().UTC()
+
+	if !link.IsActive(now) {
+		return models.ErrLinkNotActive
+	}
+
+	if link.IsExpired(now) {
+		return models.ErrLinkExpired
+	}
+
+	if link.IsClickLimitReached() {
+		return models.ErrLinkClickLimitReached
+	}
+
+	return nil
+}
+
+func fallbackOrError(link *models.Link, err error) (*models.RedirectResponse, error) {
+	if link.FallbackURL.Valid && !errors.Is(err, models.ErrLinkNotActive) {
+		return &models.RedirectResponse{
+			DestinationURL: link.FallbackURL.String,
+			StatusCode:     fallbackStatusCode(link),
+		}, nil
+	}
+
+	return nil, err
+}
+
+// The fallback only stands in while the link is expired or out of clicks, which
+// the owner can undo, so it is never permanent. It keeps whether the link's own
+// redirect type preserves the request method.
+func fallbackStatusCode(link *models.Link) int {
+	if link.RedirectType == http.StatusTemporaryRedirect || link.RedirectType == http.StatusPermanentRedirect {
+		return http.StatusTemporaryRedirect
+	}
+
+	return http.StatusFound
+}
+
+// The unlock form is a POST carrying the password, and a method-preserving
+// redirect would replay it to the destination.
+func unlockStatusCode(statusCode int) int {
+	if statusCode == http.StatusTemporaryRedirect || statusCode == http.StatusPermanentRedirect {
+		return http.StatusSeeOther
+	}
+
+	return statusCode
 }
@@ -0,0 +1,839 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetOriginalURLWithTracking(t *testing.T) {
+	t.Run("when the link is active, it should return the original URL", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com", ShortCode: "abcd1234"}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "127.0.0.1", UserAgent: "agent"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, link.OriginalURL, response.DestinationURL)
+		assert.Equal(t, http.StatusFound, response.StatusCode)
+		mockLinkService.AssertExpectations(t)
+		mockLinkVisitService.AssertExpectations(t)
+	})
+
+	t.Run("when the link uses a permanent redirect, it should return its status code", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com", ShortCode: "abcd1234", RedirectType: http.StatusPermanentRedirect}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusPermanentRedirect, response.StatusCode)
+		assert.True(t, response.Cacheable)
+	})
+
+	t.Run("when a permanent link is password protected, it should not be publicly cacheable", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		mockTokenService := new(mocks.TokenServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			ts:  mockTokenService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:           uuid.New().String(),
+			OriginalURL:  "https://example.com",
+			ShortCode:    "abcd1234",
+			RedirectType: http.StatusMovedPermanently,
+			PasswordHash: sql.NullString{String: "hash", Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockTokenService.On("ValidateLinkAccessToken", ctx, "token", link.ID).Return(nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, AccessToken: "token"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusMovedPermanently, response.StatusCode)
+		assert.False(t, response.Cacheable)
+	})
+
+	t.Run("when a permanent link is limited by clicks or dates, it should not be publicly cacheable", func(t *testing.T) {
+		now := time.Now().UTC()
+		links := map[string]*models.Link{
+			"max clicks": {MaxClicks: sql.NullInt64{Int64: 100, Valid: true}},
+			"expiring":   {ExpiresAt: sql.NullTime{Time: now.Add(time.Hour), Valid: true}},
+			"scheduled":  {ActivatesAt: sql.NullTime{Time: now.Add(-time.Hour), Valid: true}},
+		}
+
+		for name, link := range links {
+			mockLinkService := new(mocks.LinkServiceMock)
+			mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+			service := &redirectService{
+				ls:  mockLinkService,
+				lvs: mockLinkVisitService,
+				lc:  newMemoryLinkCache(models.LinkCache{}),
+				gl:  newGeoIPLocator(models.GeoIP{}),
+			}
+
+			ctx := context.Background()
+			link.ID = uuid.New().String()
+			link.OriginalURL = "https://example.com"
+			link.ShortCode = "abcd1234"
+			link.RedirectType = http.StatusPermanentRedirect
+
+			mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+			mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+			response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode})
+
+			assert.NoError(t, err, name)
+			assert.False(t, response.Cacheable, name)
+		}
+	})
+
+	t.Run("when the link is expired without fallback, it should return ErrLinkExpired", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com",
+			ShortCode:   "abcd1234",
+			ExpiresAt:   sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "127.0.0.1", UserAgent: "agent"})
+
+		assert.ErrorIs(t, err, models.ErrLinkExpired)
+		assert.Nil(t, response)
+		mockLinkVisitService.AssertNotCalled(t, "CreateLinkVisit", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the link is expired with fallback, it should return the fallback URL", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com",
+			ShortCode:   "abcd1234",
+			ExpiresAt:   sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true},
+			FallbackURL: sql.NullString{String: "https://example.com/ended", Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "127.0.0.1", UserAgent: "agent"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/ended", response.DestinationURL)
+		assert.Equal(t, http.StatusFound, response.StatusCode)
+	})
+
+	t.Run("when the expired link uses a permanent redirect, it should fall back with a temporary one", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		service := &redirectService{
+			ls: mockLinkService,
+			lc: newMemoryLinkCache(models.LinkCache{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:           uuid.New().String(),
+			OriginalURL:  "https://example.com",
+			ShortCode:    "abcd1234",
+			RedirectType: http.StatusPermanentRedirect,
+			ExpiresAt:    sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true},
+			FallbackURL:  sql.NullString{String: "https://example.com/ended", Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusTemporaryRedirect, response.StatusCode)
+		assert.False(t, response.Cacheable)
+	})
+	t.Run("when the link is not active yet, it should return ErrLinkNotActive even with fallback", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com/launch",
+			ShortCode:   "abcd1234",
+			ActivatesAt: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+			FallbackURL: sql.NullString{String: "https://example.com/ended", Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode})
+
+		assert.ErrorIs(t, err, models.ErrLinkNotActive)
+		assert.Nil(t, response)
+		mockLinkVisitService.AssertNotCalled(t, "CreateLinkVisit", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the click limit is already reached, it should return ErrLinkClickLimitReached", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com",
+			ShortCode:   "abcd1234",
+			MaxClicks:   sql.NullInt64{Int64: 1, Valid: true},
+			ClickCount:  1,
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "127.0.0.1", UserAgent: "agent"})
+
+		assert.ErrorIs(t, err, models.ErrLinkClickLimitReached)
+		assert.Nil(t, response)
+		mockLinkVisitService.AssertNotCalled(t, "CreateLinkVisit", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a concurrent redirect consumes the last click, it should return ErrLinkClickLimitReached", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com",
+			ShortCode:   "abcd1234",
+			MaxClicks:   sql.NullInt64{Int64: 1, Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).
+			Return(fmt.Errorf("register visit: %w", models.ErrLinkClickLimitReached))
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "127.0.0.1", UserAgent: "agent"})
+
+		assert.ErrorIs(t, err, models.ErrLinkClickLimitReached)
+		assert.Nil(t, response)
+		mockLinkVisitService.AssertExpectations(t)
+	})
+	t.Run("when the link is password protected without access token, it should return ErrLinkPasswordRequired", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:           uuid.New().String(),
+			OriginalURL:  "https://example.com",
+			ShortCode:    "abcd1234",
+			PasswordHash: sql.NullString{String: "hash", Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode})
+
+		assert.ErrorIs(t, err, models.ErrLinkPasswordRequired)
+		assert.Nil(t, response)
+		mockLinkVisitService.AssertNotCalled(t, "CreateLinkVisit", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the link is password protected with a valid access token, it should return the original URL", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		mockTokenService := new(mocks.TokenServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			ts:  mockTokenService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:           uuid.New().String(),
+			OriginalURL:  "https://example.com",
+			ShortCode:    "abcd1234",
+			PasswordHash: sql.NullString{String: "hash", Valid: true},
+		}
+		request := models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "127.0.0.1", UserAgent: "agent", AccessToken: "token"}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockTokenService.On("ValidateLinkAccessToken", ctx, "token", link.ID).Return(nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, link.OriginalURL, response.DestinationURL)
+		mockTokenService.AssertExpectations(t)
+	})
+}
+
+func TestUnlockLink(t *testing.T) {
+	t.Run("when the password is valid, it should return the destination and an access token", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		mockSecurityService := new(mocks.SecurityServiceMock)
+		mockTokenService := new(mocks.TokenServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			ss:  mockSecurityService,
+			ts:  mockTokenService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+			ul:  newMemoryUnlockLimiter(models.UnlockLimit{Size: 10, MaxAttempts: 5, LinkMaxAttempts: 10, Window: time.Minute}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:           uuid.New().String(),
+			OriginalURL:  "https://example.com",
+			ShortCode:    "abcd1234",
+			PasswordHash: sql.NullString{String: "hash", Valid: true},
+		}
+		request := models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "127.0.0.1", UserAgent: "agent"}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockSecurityService.On("VerifyPassword", ctx, "hash", "secret").Return(nil)
+		mockTokenService.On("GenerateLinkAccessToken", ctx, link.ID, mock.Anything, mock.Anything).Return("token", nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		response, err := service.UnlockLink(ctx, request, "secret")
+
+		assert.NoError(t, err)
+		assert.Equal(t, link.OriginalURL, response.DestinationURL)
+		assert.Equal(t, http.StatusFound, response.StatusCode)
+		assert.Equal(t, "token", response.AccessToken)
+		mockLinkVisitService.AssertExpectations(t)
+	})
+
+	t.Run("when the link uses a method-preserving redirect, it should answer the unlock with 303", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:           uuid.New().String(),
+			OriginalURL:  "https://example.com",
+			ShortCode:    "abcd1234",
+			RedirectType: http.StatusPermanentRedirect,
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		response, err := service.UnlockLink(ctx, models.RedirectRequest{ShortCode: link.ShortCode}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusSeeOther, response.StatusCode)
+	})
+
+	t.Run("when the password is invalid, it should return ErrInvalidLinkPassword", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		mockSecurityService := new(mocks.SecurityServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			ss:  mockSecurityService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+			ul:  newMemoryUnlockLimiter(models.UnlockLimit{Size: 10, MaxAttempts: 5, LinkMaxAttempts: 10, Window: time.Minute}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:           uuid.New().String(),
+			OriginalURL:  "https://example.com",
+			ShortCode:    "abcd1234",
+			PasswordHash: sql.NullString{String: "hash", Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockSecurityService.On("VerifyPassword", ctx, "hash", "wrong").Return(errors.New("mismatch"))
+
+		response, err := service.UnlockLink(ctx, models.RedirectRequest{ShortCode: link.ShortCode}, "wrong")
+
+		assert.ErrorIs(t, err, models.ErrInvalidLinkPassword)
+		assert.Nil(t, response)
+		mockLinkVisitService.AssertNotCalled(t, "CreateLinkVisit", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a client keeps guessing wrong, it should stop checking passwords after the attempt limit", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockSecurityService := new(mocks.SecurityServiceMock)
+		service := &redirectService{
+			ls: mockLinkService,
+			ss: mockSecurityService,
+			lc: newMemoryLinkCache(models.LinkCache{}),
+			ul: newMemoryUnlockLimiter(models.UnlockLimit{Size: 10, MaxAttempts: 2, LinkMaxAttempts: 10, Window: time.Minute}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:           uuid.New().String(),
+			OriginalURL:  "https://example.com",
+			ShortCode:    "abcd1234",
+			PasswordHash: sql.NullString{String: "hash", Valid: true},
+		}
+		request := models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "127.0.0.1"}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockSecurityService.On("VerifyPassword", ctx, "hash", "wrong").Return(errors.New("mismatch"))
+
+		for range 2 {
+			_, err := service.UnlockLink(ctx, request, "wrong")
+			assert.ErrorIs(t, err, models.ErrInvalidLinkPassword)
+		}
+
+		response, err := service.UnlockLink(ctx, request, "secret")
+
+		assert.ErrorIs(t, err, models.ErrTooManyUnlockAttempts)
+		assert.Nil(t, response)
+		mockSecurityService.AssertNumberOfCalls(t, "VerifyPassword", 2)
+	})
+
+	t.Run("when guesses arrive in parallel, it should verify at most the attempt limit", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockSecurityService := new(mocks.SecurityServiceMock)
+		service := &redirectService{
+			ls: mockLinkService,
+			ss: mockSecurityService,
+			lc: newMemoryLinkCache(models.LinkCache{}),
+			ul: newMemoryUnlockLimiter(models.UnlockLimit{Size: 10, MaxAttempts: 3, LinkMaxAttempts: 100, Window: time.Minute}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:           uuid.New().String(),
+			OriginalURL:  "https://example.com",
+			ShortCode:    "abcd1234",
+			PasswordHash: sql.NullString{String: "hash", Valid: true},
+		}
+		request := models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "127.0.0.1"}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockSecurityService.On("VerifyPassword", ctx, "hash", "wrong").
+			Run(func(mock.Arguments) { time.Sleep(10 * time.Millisecond) }).
+			Return(errors.New("mismatch"))
+
+		var wg sync.WaitGroup
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				service.UnlockLink(ctx, request, "wrong")
+			}()
+		}
+		wg.Wait()
+
+		mockSecurityService.AssertNumberOfCalls(t, "VerifyPassword", 3)
+	})
+}
+
+func TestRedirectLinkCache(t *testing.T) {
+	t.Run("when the link is cached, it should not hit the link service again", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{Size: 10, TTL: time.Minute}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com", ShortCode: "abcd1234"}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil).Once()
+		mockLinkVisitService.On("CreateLinkVisit", ctx, mock.Anything, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		for range 3 {
+			response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode})
+			assert.NoError(t, err)
+			assert.Equal(t, link.OriginalURL, response.DestinationURL)
+		}
+
+		mockLinkService.AssertNumberOfCalls(t, "GetLinkByShortCode", 1)
+	})
+
+	t.Run("when the code is unknown, it should cache the miss", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		service := &redirectService{
+			ls: mockLinkService,
+			lc: newMemoryLinkCache(models.LinkCache{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute}),
+		}
+
+		ctx := context.Background()
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", "missing").Return(nil, models.ErrLinkNotFound).Once()
+
+		for range 2 {
+			_, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: "missing"})
+			assert.ErrorIs(t, err, models.ErrLinkNotFound)
+		}
+
+		mockLinkService.AssertNumberOfCalls(t, "GetLinkByShortCode", 1)
+	})
+}
+
+func TestRedirectHostResolution(t *testing.T) {
+	t.Run("when the link has a custom domain, it should only resolve on that host", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:          mockLinkService,
+			lvs:         mockLinkVisitService,
+			lc:          newMemoryLinkCache(models.LinkCache{}),
+			gl:          newGeoIPLocator(models.GeoIP{}),
+			defaultHost: "sho.rt",
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://acme.com/launch",
+			ShortCode:   "launch",
+			Domain:      sql.NullString{String: "go.acme.com", Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "go.acme.com", link.ShortCode).Return(link, nil)
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(nil, models.ErrLinkNotFound)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{Host: "GO.acme.com:443", ShortCode: link.ShortCode})
+		assert.NoError(t, err)
+		assert.Equal(t, link.OriginalURL, response.DestinationURL)
+
+		_, err = service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{Host: "sho.rt", ShortCode: link.ShortCode})
+		assert.ErrorIs(t, err, models.ErrLinkNotFound)
+	})
+
+	t.Run("when the link has no custom domain, it should not resolve on a custom host", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		service := &redirectService{
+			ls:          mockLinkService,
+			lc:          newMemoryLinkCache(models.LinkCache{}),
+			defaultHost: "sho.rt",
+		}
+
+		ctx := context.Background()
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "go.acme.com", "abcd1234").Return(nil, models.ErrLinkNotFound)
+
+		_, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{Host: "go.acme.com", ShortCode: "abcd1234"})
+
+		assert.ErrorIs(t, err, models.ErrLinkNotFound)
+		mockLinkService.AssertNotCalled(t, "GetLinkByShortCode", ctx, "", "abcd1234")
+	})
+
+	t.Run("when two domains use the same short code, it should resolve each by host", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:          mockLinkService,
+			lvs:         mockLinkVisitService,
+			lc:          newMemoryLinkCache(models.LinkCache{}),
+			gl:          newGeoIPLocator(models.GeoIP{}),
+			defaultHost: "sho.rt",
+		}
+
+		ctx := context.Background()
+		defaultLink := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com/promo", ShortCode: "promo"}
+		domainLink := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://acme.com/promo",
+			ShortCode:   "promo",
+			Domain:      sql.NullString{String: "go.acme.com", Valid: true},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", "promo").Return(defaultLink, nil)
+		mockLinkService.On("GetLinkByShortCode", ctx, "go.acme.com", "promo").Return(domainLink, nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, mock.Anything, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{Host: "sho.rt", ShortCode: "promo"})
+		assert.NoError(t, err)
+		assert.Equal(t, defaultLink.OriginalURL, response.DestinationURL)
+
+		response, err = service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{Host: "go.acme.com", ShortCode: "promo"})
+		assert.NoError(t, err)
+		assert.Equal(t, domainLink.OriginalURL, response.DestinationURL)
+	})
+}
+
+func TestRedirectDestinationRotation(t *testing.T) {
+	destinations := []models.LinkDestination{
+		{ID: "a", URL: "https://example.com/a", Weight: 1},
+		{ID: "b", URL: "https://example.com/b", Weight: 1000},
+	}
+
+	t.Run("when the link has weighted destinations, it should record the chosen destination on the visit", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com", ShortCode: "abcd1234", Destinations: destinations}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.MatchedBy(func(request models.RedirectRequest) bool {
+			return request.DestinationID == "a" || request.DestinationID == "b"
+		})).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode})
+
+		assert.NoError(t, err)
+		assert.Contains(t, []string{"https://example.com/a", "https://example.com/b"}, response.DestinationURL)
+		assert.NotEmpty(t, response.DestinationID)
+		assert.False(t, response.StickyDestination)
+		mockLinkVisitService.AssertExpectations(t)
+	})
+
+	t.Run("when the link is sticky and the visitor has a known destination, it should keep it", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com", ShortCode: "abcd1234", Destinations: destinations, StickyDestinations: true}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.MatchedBy(func(request models.RedirectRequest) bool {
+			return request.DestinationID == "a"
+		})).Return(nil)
+
+		for range 5 {
+			response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, DestinationID: "a"})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "https://example.com/a", response.DestinationURL)
+			assert.True(t, response.StickyDestination)
+		}
+	})
+
+	t.Run("when the link has no destinations, it should ignore a stale destination cookie", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com", ShortCode: "abcd1234"}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.MatchedBy(func(request models.RedirectRequest) bool {
+			return request.DestinationID == ""
+		})).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, DestinationID: "a"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, link.OriginalURL, response.DestinationURL)
+		assert.Empty(t, response.DestinationID)
+	})
+}
+
+func TestRedirectLinkRules(t *testing.T) {
+	t.Run("when a rule matches the visitor, it should override the default destination", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  newGeoIPLocator(models.GeoIP{}),
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com",
+			ShortCode:   "abcd1234",
+			Rules: []models.LinkRule{
+				{ID: "br", DestinationURL: "https://example.com.br", Conditions: []models.RuleCondition{{Type: models.RuleConditionCountry, Values: []string{"BR"}}}},
+			},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.AnythingOfType("models.RedirectRequest")).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, Country: "br"})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com.br", response.DestinationURL)
+		assert.False(t, response.Cacheable)
+
+		response, err = service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, Country: "US"})
+		assert.NoError(t, err)
+		assert.Equal(t, link.OriginalURL, response.DestinationURL)
+	})
+}
+
+func TestRedirectGeoIPEnrichment(t *testing.T) {
+	t.Run("when the country header is missing, it should locate the visitor from the IP address", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		mockGeoIPLocator := new(mocks.GeoIPLocatorMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  mockGeoIPLocator,
+		}
+
+		ctx := context.Background()
+		link := &models.Link{
+			ID:          uuid.New().String(),
+			OriginalURL: "https://example.com",
+			ShortCode:   "abcd1234",
+			Rules: []models.LinkRule{
+				{ID: "br", DestinationURL: "https://example.com.br", Conditions: []models.RuleCondition{{Type: models.RuleConditionCountry, Values: []string{"BR"}}}},
+			},
+		}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockGeoIPLocator.On("Lookup", "203.0.113.7").Return(models.GeoLocation{Country: "BR", Region: "Sao Paulo", City: "Campinas"})
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.MatchedBy(func(request models.RedirectRequest) bool {
+			return request.Country == "BR" && request.Region == "Sao Paulo" && request.City == "Campinas"
+		})).Return(nil)
+
+		response, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "203.0.113.7"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com.br", response.DestinationURL)
+		mockLinkVisitService.AssertExpectations(t)
+	})
+
+	t.Run("when the country header disagrees with the database, it should keep the header and drop the city", func(t *testing.T) {
+		mockLinkService := new(mocks.LinkServiceMock)
+		mockLinkVisitService := new(mocks.LinkVisitServiceMock)
+		mockGeoIPLocator := new(mocks.GeoIPLocatorMock)
+		service := &redirectService{
+			ls:  mockLinkService,
+			lvs: mockLinkVisitService,
+			lc:  newMemoryLinkCache(models.LinkCache{}),
+			gl:  mockGeoIPLocator,
+		}
+
+		ctx := context.Background()
+		link := &models.Link{ID: uuid.New().String(), OriginalURL: "https://example.com", ShortCode: "abcd1234"}
+
+		mockLinkService.On("GetLinkByShortCode", ctx, "", link.ShortCode).Return(link, nil)
+		mockGeoIPLocator.On("Lookup", "203.0.113.7").Return(models.GeoLocation{Country: "BR", Region: "Sao Paulo", City: "Campinas"})
+		mockLinkVisitService.On("CreateLinkVisit", ctx, link, mock.MatchedBy(func(request models.RedirectRequest) bool {
+			return request.Country == "PT" && request.Region == "" && request.City == ""
+		})).Return(nil)
+
+		_, err := service.GetOriginalURLWithTracking(ctx, models.RedirectRequest{ShortCode: link.ShortCode, IPAddress: "203.0.113.7", Country: "pt"})
+
+		assert.NoError(t, err)
+		mockLinkVisitService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+var apiVersionPattern = regexp.MustCompile(`^v[0-9]+$`)
+
+type ReservedCodeRegistry interface {
+	Reserve(codes ...string)
+	IsReserved(code string) bool
+}
+
+type reservedCodeRegistry struct {
+	i     *di.Injector
+	mu    sync.RWMutex
+	codes map[string]struct{}
+}
+
+func NewReservedCodeRegistry(i *di.Injector) (ReservedCodeRegistry, error) {
+	registry := newReservedCodeRegistry()
+	registry.i = i
+	registry.Reserve(config.Env.ReservedCodes...)
+
+	return registry, nil
+}
+
+func newReservedCodeRegistry() *reservedCodeRegistry {
+	return &reservedCodeRegistry{
+		codes: make(map[string]struct{}),
+	}
+}
+
+func (r *reservedCodeRegistry) Reserve(codes ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, code := range codes {
+		code = strings.ToLower(strings.TrimSpace(code))
+		if code != "" {
+			r.codes[code] = struct{}{}
+		}
+	}
+}
+
+func (r *reservedCodeRegistry) IsReserved(code string) bool {
+	code = strings.ToLower(code)
+
+	if apiVersionPattern.MatchString(code) {
+		return true
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, reserved := r.codes[code]
+	return reserved
+}
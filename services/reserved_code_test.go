@@ -0,0 +1,28 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReservedCodeRegistry(t *testing.T) {
+	t.Run("when a code is reserved, it should match regardless of case", func(t *testing.T) {
+		registry := newReservedCodeRegistry()
+
+		registry.Reserve("Login", " admin ")
+
+		assert.True(t, registry.IsReserved("login"))
+		assert.True(t, registry.IsReserved("LOGIN"))
+		assert.True(t, registry.IsReserved("admin"))
+		assert.False(t, registry.IsReserved("promo"))
+	})
+
+	t.Run("when a code looks like an API version, it should be reserved", func(t *testing.T) {
+		registry := newReservedCodeRegistry()
+
+		assert.True(t, registry.IsReserved("v1"))
+		assert.True(t, registry.IsReserved("v42"))
+		assert.False(t, registry.IsReserved("v1a"))
+	})
+}
@@ -2,23 +2,41 @@ package services
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
+	"github.com/g-villarinho/link-fizz-api/config"
 	"github.com/g-villarinho/link-fizz-api/models"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
 	"github.com/g-villarinho/link-fizz-api/repositories"
 	"github.com/google/uuid"
 )
 
+const refreshTokenSecretLength = 32
+
 type SessionService interface {
-	CreateSession(ctx context.Context, userID, IPAddress, userAgent string) (string, error)
+	CreateSession(ctx context.Context, userID, IPAddress, userAgent string) (*models.LoginResponse, error)
+	RefreshSession(ctx context.Context, refreshToken, IPAddress, userAgent string) (*models.LoginResponse, error)
 	DeleteSession(ctx context.Context, sessionID string) error
+	GetUserSessions(ctx context.Context, userID, currentSessionID string) ([]models.SessionResponse, error)
+	RevokeUserSession(ctx context.Context, userID, sessionID string) error
+	RevokeOtherSessions(ctx context.Context, userID, currentSessionID string) (*models.RevokeSessionsResponse, error)
+	ValidateSession(ctx context.Context, userID, sessionID string) error
 }
 
 type sessionService struct {
 	i  *di.Injector
 	ts TokenService
+	ls LogoutService
+	sc SessionCache
 	sr repositories.SessionRepository
 }
 
@@ -28,6 +46,16 @@ func NewSessionService(i *di.Injector) (SessionService, error) {
 		return nil, fmt.Errorf("invoke services.Token: %w", err)
 	}
 
+	logoutService, err := di.Invoke[LogoutService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.Logout: %w", err)
+	}
+
+	sessionCache, err := di.Invoke[SessionCache](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.SessionCache: %w", err)
+	}
+
 	sessionRepository, err := di.Invoke[repositories.SessionRepository](i)
 	if err != nil {
 		return nil, fmt.Errorf("invoke repositories.Session: %w", err)
@@ -36,39 +64,132 @@ func NewSessionService(i *di.Injector) (SessionService, error) {
 	return &sessionService{
 		i:  i,
 		ts: tokenService,
+		ls: logoutService,
+		sc: sessionCache,
 		sr: sessionRepository,
 	}, nil
 }
 
-func (s *sessionService) CreateSession(ctx context.Context, userID string, IPAddress string, userAgent string) (string, error) {
+func (s *sessionService) CreateSession(ctx context.Context, userID string, IPAddress string, userAgent string) (*models.LoginResponse, error) {
 	sessionID, err := uuid.NewRandom()
 	if err != nil {
-		return "", fmt.Errorf("generate session id: %w", err)
+		return nil, fmt.Errorf("generate session id: %w", err)
 	}
 
 	now := time.Now().UTC()
-	expiresAt := now.Add(time.Hour * 24 * 7)
-
-	token, err := s.ts.GenerateToken(ctx, userID, sessionID.String(), now, expiresAt)
-	if err != nil {
-		return "", fmt.Errorf("generate token: %w", err)
-	}
 
 	session := &models.Session{
 		ID:       sessionID.String(),
 		UserID:   userID,
-		Token:    token,
 		IP:       IPAddress,
 		Agent:    userAgent,
 		CreateAt: now,
-		ExpireAt: expiresAt,
+	}
+
+	response, err := s.issueTokens(ctx, session, now)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := s.sr.CreateSession(ctx, session); err != nil {
-		return "", fmt.Errorf("create session: %w", err)
+		return nil, fmt.Errorf("create session: %w", err)
 	}
 
-	return token, nil
+	return response, nil
+}
+
+func (s *sessionService) RefreshSession(ctx context.Context, refreshToken string, IPAddress string, userAgent string) (*models.LoginResponse, error) {
+	logger := slog.With(
+		"service", "session",
+		"method", "RefreshSession",
+	)
+
+	sessionID, _, found := strings.Cut(refreshToken, ".")
+	if !found || sessionID == "" {
+		return nil, models.ErrInvalidRefreshToken
+	}
+
+	session, err := s.sr.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("get session by id: %w", err)
+	}
+
+	if session == nil {
+		return nil, models.ErrInvalidRefreshToken
+	}
+
+	now := time.Now().UTC()
+
+	refreshTokenHash := hashRefreshToken(refreshToken)
+	if subtle.ConstantTimeCompare([]byte(refreshTokenHash), []byte(session.RefreshTokenHash)) != 1 {
+		// Only a token this session issued and later rotated proves reuse; anything
+		// else is a guess at the session ID and must not log the device out.
+		retiredAt, err := s.sr.GetRefreshTokenRetiredAt(ctx, session.ID, refreshTokenHash)
+		if err != nil {
+			return nil, fmt.Errorf("get refresh token retired at: %w", err)
+		}
+
+		if !retiredAt.Valid {
+			logger.Warn("refresh token does not match session", "session_id", session.ID)
+			return nil, models.ErrInvalidRefreshToken
+		}
+
+		// A token rotated moments ago is most likely a retry or a second tab
+		// racing the refresh that rotated it, so only that request fails.
+		if now.Sub(retiredAt.Time) < config.Env.RefreshTokenReuseGrace {
+			logger.Info("refresh token rotated within the grace window", "session_id", session.ID)
+			return nil, models.ErrInvalidRefreshToken
+		}
+
+		logger.Warn("refresh token reused, revoking session", "session_id", session.ID, "user_id", session.UserID)
+
+		if err := s.revokeSession(ctx, session); err != nil {
+			return nil, err
+		}
+
+		return nil, models.ErrRefreshTokenReused
+	}
+
+	if !now.Before(session.ExpireAt) {
+		if err := s.DeleteSession(ctx, session.ID); err != nil {
+			return nil, err
+		}
+
+		return nil, models.ErrInvalidRefreshToken
+	}
+
+	if err := s.ValidateSession(ctx, session.UserID, session.ID); err != nil {
+		if err == models.ErrSessionNotFound || err == models.ErrSessionExpired {
+			return nil, models.ErrInvalidRefreshToken
+		}
+
+		return nil, err
+	}
+
+	session.IP = IPAddress
+	session.Agent = userAgent
+	session.RefreshedAt = sql.NullTime{Time: now, Valid: true}
+
+	response, err := s.issueTokens(ctx, session, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// A hash retired longer than a refresh token lives belongs to a token that
+	// has expired on its own, so reuse detection no longer needs it.
+	rotated, err := s.sr.RotateSession(ctx, session, refreshTokenHash, now.Add(-config.Env.RefreshTokenTTL))
+	if err != nil {
+		return nil, fmt.Errorf("rotate session: %w", err)
+	}
+
+	if !rotated {
+		// Another request holding the same valid token won the rotation; fail
+		// only this one and keep the session the winner just refreshed.
+		logger.Info("refresh token rotated concurrently", "session_id", session.ID)
+		return nil, models.ErrInvalidRefreshToken
+	}
+
+	return response, nil
 }
 
 func (s *sessionService) DeleteSession(ctx context.Context, sessionID string) error {
@@ -76,5 +197,143 @@ func (s *sessionService) DeleteSession(ctx context.Context, sessionID string) er
 		return fmt.Errorf("delete session: %w", err)
 	}
 
+	s.sc.Invalidate(sessionID)
+
 	return nil
 }
+
+func (s *sessionService) GetUserSessions(ctx context.Context, userID string, currentSessionID string) ([]models.SessionResponse, error) {
+	sessions, err := s.sr.GetSessionsByUserID(ctx, userID, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("get sessions by user id: %w", err)
+	}
+
+	responses := make([]models.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		response := session.ToResponse(currentSessionID)
+		response.Device, response.OS = parseUserAgent(session.Agent)
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+func (s *sessionService) RevokeUserSession(ctx context.Context, userID string, sessionID string) error {
+	session, err := s.sr.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("get session by id: %w", err)
+	}
+
+	if session == nil || session.UserID != userID {
+		return models.ErrSessionNotFound
+	}
+
+	return s.revokeSession(ctx, session)
+}
+
+func (s *sessionService) RevokeOtherSessions(ctx context.Context, userID string, currentSessionID string) (*models.RevokeSessionsResponse, error) {
+	sessions, err := s.sr.GetSessionsByUserID(ctx, userID, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("get sessions by user id: %w", err)
+	}
+
+	response := &models.RevokeSessionsResponse{}
+	for _, session := range sessions {
+		if session.ID == currentSessionID {
+			continue
+		}
+
+		if err := s.revokeSession(ctx, &session); err != nil {
+			return nil, err
+		}
+		response.Revoked++
+	}
+
+	return response, nil
+}
+
+func (s *sessionService) ValidateSession(ctx context.Context, userID string, sessionID string) error {
+	state, hit := s.sc.Get(sessionID)
+	if !hit {
+		var err error
+		state, err = s.sr.GetSessionState(ctx, sessionID)
+		if err != nil {
+			return fmt.Errorf("get session state: %w", err)
+		}
+
+		s.sc.Set(sessionID, state)
+	}
+
+	if state == nil || state.UserID != userID {
+		return models.ErrSessionNotFound
+	}
+
+	if !time.Now().UTC().Before(state.ExpireAt) {
+		return models.ErrSessionExpired
+	}
+
+	if state.UserDisabled {
+		return models.ErrUserDisabled
+	}
+
+	return nil
+}
+
+func (s *sessionService) issueTokens(ctx context.Context, session *models.Session, now time.Time) (*models.LoginResponse, error) {
+	// Refreshing slides the expiry forward, but never past the session's absolute lifetime.
+	session.ExpireAt = now.Add(config.Env.RefreshTokenTTL)
+	if maxExpireAt := session.CreateAt.Add(config.Env.MaxSessionLifetime); session.ExpireAt.After(maxExpireAt) {
+		session.ExpireAt = maxExpireAt
+	}
+
+	tokenExpiresAt := now.Add(config.Env.AccessTokenTTL)
+	if tokenExpiresAt.After(session.ExpireAt) {
+		tokenExpiresAt = session.ExpireAt
+	}
+
+	token, err := s.ts.GenerateToken(ctx, session.UserID, session.ID, now, tokenExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("generate token: %w", err)
+	}
+
+	refreshToken, err := generateRefreshToken(session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	session.Token = token
+	session.RefreshTokenHash = hashRefreshToken(refreshToken)
+
+	return &models.LoginResponse{
+		Token:                 token,
+		TokenExpiresAt:        tokenExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: session.ExpireAt,
+	}, nil
+}
+
+func (s *sessionService) revokeSession(ctx context.Context, session *models.Session) error {
+	if err := s.DeleteSession(ctx, session.ID); err != nil {
+		return err
+	}
+
+	if err := s.ls.CreateLogout(ctx, &session.UserID, session.Token); err != nil {
+		return fmt.Errorf("create logout: %w", err)
+	}
+
+	return nil
+}
+
+func generateRefreshToken(sessionID string) (string, error) {
+	secret := make([]byte, refreshTokenSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return sessionID + "." + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func hashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+type SessionCache interface {
+	Get(sessionID string) (state *models.SessionState, hit bool)
+	Set(sessionID string, state *models.SessionState)
+	Invalidate(sessionID string)
+	InvalidateUser(userID string)
+}
+
+type sessionCacheEntry struct {
+	sessionID string
+	state     *models.SessionState
+	expiresAt time.Time
+}
+
+type memorySessionCache struct {
+	mu      sync.Mutex
+	cfg     models.SessionCache
+	entries map[string]*list.Element
+	order   *list.List
+	now     func() time.Time
+}
+
+func NewSessionCache(i *di.Injector) (SessionCache, error) {
+	return newMemorySessionCache(config.Env.SessionCache), nil
+}
+
+func newMemorySessionCache(cfg models.SessionCache) *memorySessionCache {
+	if cfg.Size <= 0 {
+		cfg.Size = 1
+	}
+
+	return &memorySessionCache{
+		cfg:     cfg,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+func (c *memorySessionCache) Get(sessionID string) (*models.SessionState, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[sessionID]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*sessionCacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	if entry.state == nil {
+		return nil, true
+	}
+
+	state := *entry.state
+	return &state, true
+}
+
+func (c *memorySessionCache) Set(sessionID string, state *models.SessionState) {
+	if c.cfg.TTL <= 0 {
+		return
+	}
+
+	if state != nil {
+		cached := *state
+		state = &cached
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(c.cfg.TTL)
+
+	if element, ok := c.entries[sessionID]; ok {
+		entry := element.Value.(*sessionCacheEntry)
+		entry.state = state
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[sessionID] = c.order.PushFront(&sessionCacheEntry{
+		sessionID: sessionID,
+		state:     state,
+		expiresAt: expiresAt,
+	})
+
+	for c.order.Len() > c.cfg.Size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *memorySessionCache) Invalidate(sessionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[sessionID]; ok {
+		c.remove(element)
+	}
+}
+
+func (c *memorySessionCache) InvalidateUser(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		if state := element.Value.(*sessionCacheEntry).state; state != nil && state.UserID == userID {
+			c.remove(element)
+		}
+		element = next
+	}
+}
+
+func (c *memorySessionCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*sessionCacheEntry).sessionID)
+}
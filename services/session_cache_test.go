@@ -0,0 +1,54 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemorySessionCache(t *testing.T) {
+	t.Run("when the TTL elapses, it should miss", func(t *testing.T) {
+		now := time.Now()
+		cache := newMemorySessionCache(models.SessionCache{Size: 10, TTL: 30 * time.Second})
+		cache.now = func() time.Time { return now }
+
+		cache.Set("s1", &models.SessionState{UserID: "u1"})
+		now = now.Add(30 * time.Second)
+
+		_, hit := cache.Get("s1")
+		assert.False(t, hit)
+	})
+
+	t.Run("when a user is invalidated, it should drop all of their sessions", func(t *testing.T) {
+		cache := newMemorySessionCache(models.SessionCache{Size: 10, TTL: time.Minute})
+
+		cache.Set("s1", &models.SessionState{UserID: "u1"})
+		cache.Set("s2", &models.SessionState{UserID: "u1"})
+		cache.Set("s3", &models.SessionState{UserID: "u2"})
+		cache.InvalidateUser("u1")
+
+		_, hit := cache.Get("s1")
+		assert.False(t, hit)
+		_, hit = cache.Get("s2")
+		assert.False(t, hit)
+		state, hit := cache.Get("s3")
+		assert.True(t, hit)
+		assert.Equal(t, "u2", state.UserID)
+	})
+
+	t.Run("when the cache is full, it should evict the least recently used session", func(t *testing.T) {
+		cache := newMemorySessionCache(models.SessionCache{Size: 2, TTL: time.Minute})
+
+		cache.Set("s1", &models.SessionState{UserID: "u1"})
+		cache.Set("s2", &models.SessionState{UserID: "u1"})
+		cache.Get("s1")
+		cache.Set("s3", &models.SessionState{UserID: "u1"})
+
+		_, hit := cache.Get("s2")
+		assert.False(t, hit)
+		_, hit = cache.Get("s1")
+		assert.True(t, hit)
+	})
+}
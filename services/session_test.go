@@ -0,0 +1,393 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setTokenLifetimes(t *testing.T, accessTokenTTL, refreshTokenTTL time.Duration) {
+	setSessionLifetimes(t, accessTokenTTL, refreshTokenTTL, 30*24*time.Hour)
+}
+
+func setSessionLifetimes(t *testing.T, accessTokenTTL, refreshTokenTTL, maxSessionLifetime time.Duration) {
+	previousAccess, previousRefresh, previousMax := config.Env.AccessTokenTTL, config.Env.RefreshTokenTTL, config.Env.MaxSessionLifetime
+	config.Env.AccessTokenTTL, config.Env.RefreshTokenTTL, config.Env.MaxSessionLifetime = accessTokenTTL, refreshTokenTTL, maxSessionLifetime
+	t.Cleanup(func() {
+		config.Env.AccessTokenTTL, config.Env.RefreshTokenTTL, config.Env.MaxSessionLifetime = previousAccess, previousRefresh, previousMax
+	})
+}
+
+func setRefreshTokenReuseGrace(t *testing.T, grace time.Duration) {
+	previous := config.Env.RefreshTokenReuseGrace
+	config.Env.RefreshTokenReuseGrace = grace
+	t.Cleanup(func() { config.Env.RefreshTokenReuseGrace = previous })
+}
+
+func TestCreateSession(t *testing.T) {
+	t.Run("when the session is created, it should store only the refresh token hash", func(t *testing.T) {
+		setTokenLifetimes(t, 15*time.Minute, 24*time.Hour)
+		mockTokenService := new(mocks.TokenServiceMock)
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{ts: mockTokenService, sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockTokenService.On("GenerateToken", ctx, userID, mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return("access-token", nil)
+
+		var stored *models.Session
+		mockSessionRepo.On("CreateSession", ctx, mock.AnythingOfType("*models.Session")).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(*models.Session) }).
+			Return(nil)
+
+		response, err := service.CreateSession(ctx, userID, "127.0.0.1", "agent")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", response.Token)
+		assert.Equal(t, 15*time.Minute, response.TokenExpiresAt.Sub(stored.CreateAt))
+		assert.Equal(t, stored.ExpireAt, response.RefreshTokenExpiresAt)
+		assert.Equal(t, hashRefreshToken(response.RefreshToken), stored.RefreshTokenHash)
+	})
+}
+
+func TestRefreshSession(t *testing.T) {
+	newSession := func(t *testing.T) (*models.Session, string) {
+		sessionID := uuid.New().String()
+		refreshToken, err := generateRefreshToken(sessionID)
+		assert.NoError(t, err)
+
+		return &models.Session{
+			ID:               sessionID,
+			UserID:           uuid.New().String(),
+			Token:            "old-access-token",
+			RefreshTokenHash: hashRefreshToken(refreshToken),
+			CreateAt:         time.Now().UTC().Add(-time.Hour),
+			ExpireAt:         time.Now().UTC().Add(time.Hour),
+		}, refreshToken
+	}
+
+	t.Run("when the refresh token is current, it should rotate it", func(t *testing.T) {
+		setTokenLifetimes(t, 15*time.Minute, 24*time.Hour)
+		mockTokenService := new(mocks.TokenServiceMock)
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{ts: mockTokenService, sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		session, refreshToken := newSession(t)
+		previousHash := session.RefreshTokenHash
+
+		mockSessionRepo.On("GetSessionByID", ctx, session.ID).Return(session, nil)
+		mockSessionRepo.On("GetSessionState", ctx, session.ID).Return(&models.SessionState{UserID: session.UserID, ExpireAt: session.ExpireAt}, nil)
+		mockTokenService.On("GenerateToken", ctx, session.UserID, session.ID, mock.Anything, mock.Anything).Return("new-access-token", nil)
+		mockSessionRepo.On("RotateSession", ctx, mock.MatchedBy(func(s *models.Session) bool {
+			return s.Token == "new-access-token" && s.RefreshTokenHash != previousHash && s.RefreshedAt.Valid
+		}), previousHash, mock.MatchedBy(func(retiredBefore time.Time) bool {
+			// Retired hashes are kept for one refresh token lifetime.
+			return retiredBefore.Sub(time.Now().UTC().Add(-24*time.Hour)).Abs() < time.Minute
+		})).Return(true, nil)
+
+		response, err := service.RefreshSession(ctx, refreshToken, "10.0.0.1", "agent")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "new-access-token", response.Token)
+		assert.NotEqual(t, refreshToken, response.RefreshToken)
+		mockSessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("when the session nears its maximum lifetime, it should not extend past it", func(t *testing.T) {
+		setSessionLifetimes(t, 15*time.Minute, 24*time.Hour, 48*time.Hour)
+		mockTokenService := new(mocks.TokenServiceMock)
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{ts: mockTokenService, sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		session, refreshToken := newSession(t)
+		session.CreateAt = time.Now().UTC().Add(-47*time.Hour - 55*time.Minute)
+		maxExpireAt := session.CreateAt.Add(48 * time.Hour)
+
+		mockSessionRepo.On("GetSessionByID", ctx, session.ID).Return(session, nil)
+		mockSessionRepo.On("GetSessionState", ctx, session.ID).Return(&models.SessionState{UserID: session.UserID, ExpireAt: session.ExpireAt}, nil)
+		mockTokenService.On("GenerateToken", ctx, session.UserID, session.ID, mock.Anything, maxExpireAt).Return("new-access-token", nil)
+		mockSessionRepo.On("RotateSession", ctx, mock.MatchedBy(func(s *models.Session) bool {
+			return s.ExpireAt.Equal(maxExpireAt)
+		}), mock.Anything, mock.Anything).Return(true, nil)
+
+		response, err := service.RefreshSession(ctx, refreshToken, "10.0.0.1", "agent")
+
+		assert.NoError(t, err)
+		assert.Equal(t, maxExpireAt, response.RefreshTokenExpiresAt)
+		assert.Equal(t, maxExpireAt, response.TokenExpiresAt)
+		mockTokenService.AssertExpectations(t)
+	})
+
+	t.Run("when any earlier rotated refresh token is reused, it should revoke the session", func(t *testing.T) {
+		setRefreshTokenReuseGrace(t, 10*time.Second)
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		mockLogoutService := new(mocks.LogoutServiceMock)
+		service := &sessionService{ls: mockLogoutService, sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		session, _ := newSession(t)
+		staleToken, err := generateRefreshToken(session.ID)
+		assert.NoError(t, err)
+
+		mockSessionRepo.On("GetSessionByID", ctx, session.ID).Return(session, nil)
+		mockSessionRepo.On("GetRefreshTokenRetiredAt", ctx, session.ID, hashRefreshToken(staleToken)).Return(sql.NullTime{Time: time.Now().UTC().Add(-time.Hour), Valid: true}, nil)
+		mockSessionRepo.On("DeleteSession", ctx, session.ID).Return(nil)
+		mockLogoutService.On("CreateLogout", ctx, &session.UserID, session.Token).Return(nil)
+
+		_, err = service.RefreshSession(ctx, staleToken, "10.0.0.1", "agent")
+
+		assert.Equal(t, models.ErrRefreshTokenReused, err)
+		mockSessionRepo.AssertExpectations(t)
+		mockLogoutService.AssertExpectations(t)
+	})
+
+	t.Run("when the refresh token was rotated within the grace window, it should return ErrInvalidRefreshToken without revoking", func(t *testing.T) {
+		setRefreshTokenReuseGrace(t, 10*time.Second)
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		mockLogoutService := new(mocks.LogoutServiceMock)
+		service := &sessionService{ls: mockLogoutService, sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		session, _ := newSession(t)
+		justRotatedToken, err := generateRefreshToken(session.ID)
+		assert.NoError(t, err)
+
+		mockSessionRepo.On("GetSessionByID", ctx, session.ID).Return(session, nil)
+		mockSessionRepo.On("GetRefreshTokenRetiredAt", ctx, session.ID, hashRefreshToken(justRotatedToken)).Return(sql.NullTime{Time: time.Now().UTC().Add(-time.Second), Valid: true}, nil)
+
+		_, err = service.RefreshSession(ctx, justRotatedToken, "10.0.0.1", "agent")
+
+		assert.Equal(t, models.ErrInvalidRefreshToken, err)
+		mockSessionRepo.AssertNotCalled(t, "DeleteSession", mock.Anything, mock.Anything)
+		mockLogoutService.AssertNotCalled(t, "CreateLogout", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a concurrent refresh wins the rotation, it should fail only the losing request", func(t *testing.T) {
+		setTokenLifetimes(t, 15*time.Minute, 24*time.Hour)
+		mockTokenService := new(mocks.TokenServiceMock)
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		mockLogoutService := new(mocks.LogoutServiceMock)
+		service := &sessionService{ts: mockTokenService, ls: mockLogoutService, sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		session, refreshToken := newSession(t)
+
+		mockSessionRepo.On("GetSessionByID", ctx, session.ID).Return(session, nil)
+		mockSessionRepo.On("GetSessionState", ctx, session.ID).Return(&models.SessionState{UserID: session.UserID, ExpireAt: session.ExpireAt}, nil)
+		mockTokenService.On("GenerateToken", ctx, session.UserID, session.ID, mock.Anything, mock.Anything).Return("new-access-token", nil)
+		mockSessionRepo.On("RotateSession", ctx, mock.Anything, hashRefreshToken(refreshToken), mock.Anything).Return(false, nil)
+
+		_, err := service.RefreshSession(ctx, refreshToken, "10.0.0.1", "agent")
+
+		assert.Equal(t, models.ErrInvalidRefreshToken, err)
+		mockSessionRepo.AssertNotCalled(t, "DeleteSession", mock.Anything, mock.Anything)
+		mockLogoutService.AssertNotCalled(t, "CreateLogout", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the refresh token was never issued for the session, it should return ErrInvalidRefreshToken without revoking", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		mockLogoutService := new(mocks.LogoutServiceMock)
+		service := &sessionService{ls: mockLogoutService, sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		session, _ := newSession(t)
+		forgedToken, err := generateRefreshToken(session.ID)
+		assert.NoError(t, err)
+
+		mockSessionRepo.On("GetSessionByID", ctx, session.ID).Return(session, nil)
+		mockSessionRepo.On("GetRefreshTokenRetiredAt", ctx, session.ID, hashRefreshToken(forgedToken)).Return(sql.NullTime{}, nil)
+
+		_, err = service.RefreshSession(ctx, forgedToken, "10.0.0.1", "agent")
+
+		assert.Equal(t, models.ErrInvalidRefreshToken, err)
+		mockSessionRepo.AssertNotCalled(t, "DeleteSession", mock.Anything, mock.Anything)
+		mockLogoutService.AssertNotCalled(t, "CreateLogout", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the session has expired, it should return ErrInvalidRefreshToken", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		session, refreshToken := newSession(t)
+		session.ExpireAt = time.Now().UTC().Add(-time.Minute)
+
+		mockSessionRepo.On("GetSessionByID", ctx, session.ID).Return(session, nil)
+		mockSessionRepo.On("DeleteSession", ctx, session.ID).Return(nil)
+
+		_, err := service.RefreshSession(ctx, refreshToken, "10.0.0.1", "agent")
+
+		assert.Equal(t, models.ErrInvalidRefreshToken, err)
+		mockSessionRepo.AssertNotCalled(t, "RotateSession", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the refresh token is malformed, it should return ErrInvalidRefreshToken", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		_, err := service.RefreshSession(context.Background(), "garbage", "10.0.0.1", "agent")
+
+		assert.Equal(t, models.ErrInvalidRefreshToken, err)
+		mockSessionRepo.AssertNotCalled(t, "GetSessionByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetUserSessions(t *testing.T) {
+	t.Run("when the user has several sessions, it should mark the current one", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		sessions := []models.Session{
+			{ID: "laptop", UserID: userID, Agent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_0)"},
+			{ID: "phone", UserID: userID, Agent: "Mozilla/5.0 (Linux; Android 14; Pixel 8) Mobile"},
+		}
+
+		mockSessionRepo.On("GetSessionsByUserID", ctx, userID, mock.AnythingOfType("time.Time")).Return(sessions, nil)
+
+		response, err := service.GetUserSessions(ctx, userID, "phone")
+
+		assert.NoError(t, err)
+		assert.Len(t, response, 2)
+		assert.False(t, response[0].Current)
+		assert.Equal(t, models.OSMacOS, response[0].OS)
+		assert.True(t, response[1].Current)
+		assert.Equal(t, models.DeviceMobile, response[1].Device)
+	})
+}
+
+func TestRevokeUserSession(t *testing.T) {
+	t.Run("when the session belongs to another user, it should return ErrSessionNotFound", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		session := &models.Session{ID: uuid.New().String(), UserID: uuid.New().String()}
+
+		mockSessionRepo.On("GetSessionByID", ctx, session.ID).Return(session, nil)
+
+		err := service.RevokeUserSession(ctx, uuid.New().String(), session.ID)
+
+		assert.Equal(t, models.ErrSessionNotFound, err)
+		mockSessionRepo.AssertNotCalled(t, "DeleteSession", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the session belongs to the user, it should delete it and revoke its access token", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		mockLogoutService := new(mocks.LogoutServiceMock)
+		service := &sessionService{ls: mockLogoutService, sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		session := &models.Session{ID: uuid.New().String(), UserID: userID, Token: "stolen-laptop-token"}
+
+		mockSessionRepo.On("GetSessionByID", ctx, session.ID).Return(session, nil)
+		mockSessionRepo.On("DeleteSession", ctx, session.ID).Return(nil)
+		mockLogoutService.On("CreateLogout", ctx, &session.UserID, session.Token).Return(nil)
+
+		err := service.RevokeUserSession(ctx, userID, session.ID)
+
+		assert.NoError(t, err)
+		mockSessionRepo.AssertExpectations(t)
+		mockLogoutService.AssertExpectations(t)
+	})
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	t.Run("when the user signs out everywhere, it should keep the current session", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		mockLogoutService := new(mocks.LogoutServiceMock)
+		service := &sessionService{ls: mockLogoutService, sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		sessions := []models.Session{
+			{ID: "current", UserID: userID, Token: "t1"},
+			{ID: "laptop", UserID: userID, Token: "t2"},
+			{ID: "tablet", UserID: userID, Token: "t3"},
+		}
+
+		mockSessionRepo.On("GetSessionsByUserID", ctx, userID, mock.AnythingOfType("time.Time")).Return(sessions, nil)
+		mockSessionRepo.On("DeleteSession", ctx, "laptop").Return(nil)
+		mockSessionRepo.On("DeleteSession", ctx, "tablet").Return(nil)
+		mockLogoutService.On("CreateLogout", ctx, mock.Anything, mock.AnythingOfType("string")).Return(nil)
+
+		response, err := service.RevokeOtherSessions(ctx, userID, "current")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, response.Revoked)
+		mockSessionRepo.AssertNotCalled(t, "DeleteSession", ctx, "current")
+		mockLogoutService.AssertNotCalled(t, "CreateLogout", ctx, mock.Anything, "t1")
+	})
+}
+
+func TestValidateSession(t *testing.T) {
+	t.Run("when the session is valid, it should cache the lookup", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{sc: newMemorySessionCache(models.SessionCache{Size: 10, TTL: time.Minute}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		sessionID := uuid.New().String()
+
+		mockSessionRepo.On("GetSessionState", ctx, sessionID).Return(&models.SessionState{UserID: userID, ExpireAt: time.Now().Add(time.Hour)}, nil)
+
+		assert.NoError(t, service.ValidateSession(ctx, userID, sessionID))
+		assert.NoError(t, service.ValidateSession(ctx, userID, sessionID))
+		mockSessionRepo.AssertNumberOfCalls(t, "GetSessionState", 1)
+	})
+
+	t.Run("when the session was revoked, it should reject it even if it was cached", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{sc: newMemorySessionCache(models.SessionCache{Size: 10, TTL: time.Minute}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		sessionID := uuid.New().String()
+
+		mockSessionRepo.On("GetSessionState", ctx, sessionID).Return(&models.SessionState{UserID: userID, ExpireAt: time.Now().Add(time.Hour)}, nil).Once()
+		mockSessionRepo.On("DeleteSession", ctx, sessionID).Return(nil)
+		mockSessionRepo.On("GetSessionState", ctx, sessionID).Return(nil, nil).Once()
+
+		assert.NoError(t, service.ValidateSession(ctx, userID, sessionID))
+		assert.NoError(t, service.DeleteSession(ctx, sessionID))
+		assert.Equal(t, models.ErrSessionNotFound, service.ValidateSession(ctx, userID, sessionID))
+	})
+
+	t.Run("when the user is disabled, it should return ErrUserDisabled", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		sessionID := uuid.New().String()
+
+		mockSessionRepo.On("GetSessionState", ctx, sessionID).Return(&models.SessionState{UserID: userID, ExpireAt: time.Now().Add(time.Hour), UserDisabled: true}, nil)
+
+		assert.Equal(t, models.ErrUserDisabled, service.ValidateSession(ctx, userID, sessionID))
+	})
+
+	t.Run("when the session belongs to another user, it should return ErrSessionNotFound", func(t *testing.T) {
+		mockSessionRepo := new(mocks.SessionRepositoryMock)
+		service := &sessionService{sc: newMemorySessionCache(models.SessionCache{}), sr: mockSessionRepo}
+
+		ctx := context.Background()
+		sessionID := uuid.New().String()
+
+		mockSessionRepo.On("GetSessionState", ctx, sessionID).Return(&models.SessionState{UserID: uuid.New().String(), ExpireAt: time.Now().Add(time.Hour)}, nil)
+
+		assert.Equal(t, models.ErrSessionNotFound, service.ValidateSession(ctx, uuid.New().String(), sessionID))
+	})
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/repositories"
+)
+
+const shortCodeGrowthCollisions = 2
+
+type ShortCodeGenerator interface {
+	Generate(ctx context.Context, domain string) (string, error)
+}
+
+type shortCodeGenerator struct {
+	i      *di.Injector
+	us     UtilsService
+	lr     repositories.LinkRepository
+	sr     repositories.ShortCodeRepository
+	rc     ReservedCodeRegistry
+	cfg    models.ShortCode
+	length atomic.Int64
+	loaded atomic.Bool
+}
+
+func NewShortCodeGenerator(i *di.Injector) (ShortCodeGenerator, error) {
+	utilsService, err := di.Invoke[UtilsService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.UtilsService: %w", err)
+	}
+
+	linkRepository, err := di.Invoke[repositories.LinkRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.LinkRepository: %w", err)
+	}
+
+	shortCodeRepository, err := di.Invoke[repositories.ShortCodeRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.ShortCodeRepository: %w", err)
+	}
+
+	reservedCodeRegistry, err := di.Invoke[ReservedCodeRegistry](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.ReservedCodeRegistry: %w", err)
+	}
+
+	generator := newShortCodeGenerator(utilsService, linkRepository, shortCodeRepository, reservedCodeRegistry, config.Env.ShortCode)
+	generator.i = i
+
+	return generator, nil
+}
+
+func newShortCodeGenerator(us UtilsService, lr repositories.LinkRepository, sr repositories.ShortCodeRepository, rc ReservedCodeRegistry, cfg models.ShortCode) *shortCodeGenerator {
+	if cfg.Length <= 0 {
+		cfg.Length = 8
+	}
+
+	if cfg.MaxLength < cfg.Length {
+		cfg.MaxLength = cfg.Length
+	}
+
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+
+	generator := &shortCodeGenerator{
+		us:  us,
+		lr:  lr,
+		sr:  sr,
+		rc:  rc,
+		cfg: cfg,
+	}
+	generator.length.Store(int64(cfg.Length))
+
+	return generator
+}
+
+func (s *shortCodeGenerator) Generate(ctx context.Context, domain string) (string, error) {
+	if err := s.loadLength(ctx); err != nil {
+		return "", err
+	}
+
+	length := int(s.length.Load())
+	collisions := 0
+
+	for range s.cfg.MaxAttempts {
+		shortCode, err := s.us.GenerateShortCode(length)
+		if err != nil {
+			return "", err
+		}
+
+		if s.rc.IsReserved(shortCode) {
+			continue
+		}
+
+		link, err := s.lr.GetLinkByShortCode(ctx, domain, shortCode)
+		if err != nil {
+			return "", fmt.Errorf("get link by short code: %w", err)
+		}
+
+		if link == nil {
+			return shortCode, nil
+		}
+
+		collisions++
+
+		// The first collision may mean another replica already grew the length.
+		if collisions == 1 {
+			if err := s.syncLength(ctx); err != nil {
+				slog.Warn("sync short code length", "error", err)
+			}
+
+			if current := int(s.length.Load()); current > length {
+				length = current
+				collisions = 0
+				continue
+			}
+		}
+
+		if collisions >= shortCodeGrowthCollisions && length < s.cfg.MaxLength {
+			length = s.grow(ctx, length+1)
+			collisions = 0
+		}
+	}
+
+	return "", models.ErrShortCodeExhausted
+}
+
+// loadLength starts from the persisted length so a restart does not fall back
+// to the configured minimum. Growth made by other replicas afterwards is picked
+// up by syncLength on the next collision. The length is shared by every domain:
+// collisions on one domain lengthen new codes on all of them.
+func (s *shortCodeGenerator) loadLength(ctx context.Context) error {
+	if s.loaded.Load() {
+		return nil
+	}
+
+	if err := s.syncLength(ctx); err != nil {
+		return err
+	}
+
+	s.loaded.Store(true)
+
+	return nil
+}
+
+func (s *shortCodeGenerator) syncLength(ctx context.Context) error {
+	length, err := s.sr.GetLength(ctx)
+	if err != nil {
+		return fmt.Errorf("get short code length: %w", err)
+	}
+
+	s.raiseLength(length)
+
+	return nil
+}
+
+func (s *shortCodeGenerator) grow(ctx context.Context, length int) int {
+	stored, err := s.sr.RaiseLength(ctx, length)
+	if err != nil {
+		slog.Warn("persist short code length", "error", err, "length", length)
+	}
+
+	s.raiseLength(max(length, stored))
+
+	return int(s.length.Load())
+}
+
+func (s *shortCodeGenerator) raiseLength(length int) {
+	length = min(length, s.cfg.MaxLength)
+
+	for {
+		current := s.length.Load()
+		if int64(length) <= current || s.length.CompareAndSwap(current, int64(length)) {
+			return
+		}
+	}
+}
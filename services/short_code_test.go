@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShortCodeGenerator(t *testing.T) {
+	t.Run("when the code is free, it should return it", func(t *testing.T) {
+		mockUtils := new(mocks.UtilsServiceMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockStateRepo := new(mocks.ShortCodeRepositoryMock)
+		generator := newShortCodeGenerator(mockUtils, mockRepo, mockStateRepo, newReservedCodeRegistry(), models.ShortCode{Length: 6, MaxLength: 10, MaxAttempts: 3})
+
+		ctx := context.Background()
+
+		mockStateRepo.On("GetLength", ctx).Return(0, nil)
+		mockUtils.On("GenerateShortCode", 6).Return("abc123", nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "abc123").Return(nil, nil)
+
+		shortCode, err := generator.Generate(ctx, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "abc123", shortCode)
+	})
+
+	t.Run("when the code collides, it should retry and skip reserved codes", func(t *testing.T) {
+		mockUtils := new(mocks.UtilsServiceMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockStateRepo := new(mocks.ShortCodeRepositoryMock)
+		registry := newReservedCodeRegistry()
+		registry.Reserve("admin1")
+		generator := newShortCodeGenerator(mockUtils, mockRepo, mockStateRepo, registry, models.ShortCode{Length: 6, MaxLength: 10, MaxAttempts: 5})
+
+		ctx := context.Background()
+
+		mockStateRepo.On("GetLength", ctx).Return(0, nil)
+		mockUtils.On("GenerateShortCode", 6).Return("admin1", nil).Once()
+		mockUtils.On("GenerateShortCode", 6).Return("taken1", nil).Once()
+		mockUtils.On("GenerateShortCode", 6).Return("free01", nil).Once()
+		mockRepo.On("GetLinkByShortCode", ctx, "", "taken1").Return(&models.Link{ShortCode: "taken1"}, nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "free01").Return(nil, nil)
+
+		shortCode, err := generator.Generate(ctx, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "free01", shortCode)
+		mockRepo.AssertNotCalled(t, "GetLinkByShortCode", ctx, "", "admin1")
+	})
+
+	t.Run("when collisions repeat, it should grow the length for later codes", func(t *testing.T) {
+		mockUtils := new(mocks.UtilsServiceMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockStateRepo := new(mocks.ShortCodeRepositoryMock)
+		generator := newShortCodeGenerator(mockUtils, mockRepo, mockStateRepo, newReservedCodeRegistry(), models.ShortCode{Length: 6, MaxLength: 10, MaxAttempts: 5})
+
+		ctx := context.Background()
+
+		mockStateRepo.On("GetLength", ctx).Return(0, nil)
+		mockUtils.On("GenerateShortCode", 6).Return("taken1", nil).Twice()
+		mockStateRepo.On("RaiseLength", ctx, 7).Return(7, nil)
+		mockUtils.On("GenerateShortCode", 7).Return("free012", nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "taken1").Return(&models.Link{ShortCode: "taken1"}, nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "free012").Return(nil, nil)
+
+		shortCode, err := generator.Generate(ctx, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "free012", shortCode)
+		assert.Equal(t, int64(7), generator.length.Load())
+		mockStateRepo.AssertExpectations(t)
+	})
+
+	t.Run("when a longer length is persisted, it should start from it", func(t *testing.T) {
+		mockUtils := new(mocks.UtilsServiceMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockStateRepo := new(mocks.ShortCodeRepositoryMock)
+		generator := newShortCodeGenerator(mockUtils, mockRepo, mockStateRepo, newReservedCodeRegistry(), models.ShortCode{Length: 6, MaxLength: 10, MaxAttempts: 3})
+
+		ctx := context.Background()
+
+		mockStateRepo.On("GetLength", ctx).Return(8, nil).Once()
+		mockUtils.On("GenerateShortCode", 8).Return("free0123", nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "free0123").Return(nil, nil)
+
+		for range 2 {
+			shortCode, err := generator.Generate(ctx, "")
+			assert.NoError(t, err)
+			assert.Equal(t, "free0123", shortCode)
+		}
+
+		mockStateRepo.AssertNumberOfCalls(t, "GetLength", 1)
+	})
+
+	t.Run("when a collision happens after another replica grew the length, it should re-read it", func(t *testing.T) {
+		mockUtils := new(mocks.UtilsServiceMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockStateRepo := new(mocks.ShortCodeRepositoryMock)
+		generator := newShortCodeGenerator(mockUtils, mockRepo, mockStateRepo, newReservedCodeRegistry(), models.ShortCode{Length: 6, MaxLength: 10, MaxAttempts: 3})
+
+		ctx := context.Background()
+
+		mockStateRepo.On("GetLength", ctx).Return(0, nil).Once()
+		mockStateRepo.On("GetLength", ctx).Return(8, nil).Once()
+		mockUtils.On("GenerateShortCode", 6).Return("taken1", nil).Once()
+		mockUtils.On("GenerateShortCode", 8).Return("free0123", nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "taken1").Return(&models.Link{ShortCode: "taken1"}, nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "free0123").Return(nil, nil)
+
+		shortCode, err := generator.Generate(ctx, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "free0123", shortCode)
+		mockStateRepo.AssertNotCalled(t, "RaiseLength", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when another replica persisted a longer length while growing, it should adopt it", func(t *testing.T) {
+		mockUtils := new(mocks.UtilsServiceMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockStateRepo := new(mocks.ShortCodeRepositoryMock)
+		generator := newShortCodeGenerator(mockUtils, mockRepo, mockStateRepo, newReservedCodeRegistry(), models.ShortCode{Length: 6, MaxLength: 10, MaxAttempts: 5})
+
+		ctx := context.Background()
+
+		mockStateRepo.On("GetLength", ctx).Return(0, nil)
+		mockStateRepo.On("RaiseLength", ctx, 7).Return(9, nil)
+		mockUtils.On("GenerateShortCode", 6).Return("taken1", nil).Twice()
+		mockUtils.On("GenerateShortCode", 9).Return("free01234", nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "taken1").Return(&models.Link{ShortCode: "taken1"}, nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "free01234").Return(nil, nil)
+
+		shortCode, err := generator.Generate(ctx, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "free01234", shortCode)
+		assert.Equal(t, int64(9), generator.length.Load())
+	})
+
+	t.Run("when every attempt collides, it should return ErrShortCodeExhausted", func(t *testing.T) {
+		mockUtils := new(mocks.UtilsServiceMock)
+		mockRepo := new(mocks.LinkRepositoryMock)
+		mockStateRepo := new(mocks.ShortCodeRepositoryMock)
+		generator := newShortCodeGenerator(mockUtils, mockRepo, mockStateRepo, newReservedCodeRegistry(), models.ShortCode{Length: 6, MaxLength: 6, MaxAttempts: 3})
+
+		ctx := context.Background()
+
+		mockStateRepo.On("GetLength", ctx).Return(0, nil)
+		mockUtils.On("GenerateShortCode", 6).Return("taken1", nil)
+		mockRepo.On("GetLinkByShortCode", ctx, "", "taken1").Return(&models.Link{ShortCode: "taken1"}, nil)
+
+		_, err := generator.Generate(ctx, "")
+
+		assert.Equal(t, models.ErrShortCodeExhausted, err)
+		mockUtils.AssertNumberOfCalls(t, "GenerateShortCode", 3)
+	})
+}
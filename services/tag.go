@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/repositories"
+	"github.com/google/uuid"
+)
+
+type TagService interface {
+	CreateTag(ctx context.Context, userID string, payload models.TagPayload) (*models.TagResponse, error)
+	GetTags(ctx context.Context, userID string) ([]models.TagResponse, error)
+	UpdateTag(ctx context.Context, userID, tagID string, payload models.TagPayload) (*models.TagResponse, error)
+	DeleteTag(ctx context.Context, userID, tagID string) error
+	GetUserTags(ctx context.Context, userID string, tagIDs []string) ([]models.Tag, error)
+	GetLinkTags(ctx context.Context, linkIDs []string) (map[string][]models.Tag, error)
+}
+
+type tagService struct {
+	i  *di.Injector
+	tr repositories.TagRepository
+}
+
+func NewTagService(i *di.Injector) (TagService, error) {
+	tagRepository, err := di.Invoke[repositories.TagRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.TagRepository: %w", err)
+	}
+
+	return &tagService{
+		i:  i,
+		tr: tagRepository,
+	}, nil
+}
+
+func (t *tagService) CreateTag(ctx context.Context, userID string, payload models.TagPayload) (*models.TagResponse, error) {
+	name, ok := normalizeLabel(payload.Name, models.MaxTagNameLength)
+	if !ok {
+		return nil, models.ErrInvalidTagName
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("generate UUID: %w", err)
+	}
+
+	tag := models.Tag{
+		ID:        id.String(),
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := t.tr.CreateTag(ctx, tag); err != nil {
+		if err == models.ErrTagAlreadyExists {
+			return nil, err
+		}
+		return nil, fmt.Errorf("create tag: %w", err)
+	}
+
+	response := tag.ToResponse()
+	return &response, nil
+}
+
+func (t *tagService) GetTags(ctx context.Context, userID string) ([]models.TagResponse, error) {
+	tags, err := t.tr.GetTagsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get tags by user ID: %w", err)
+	}
+
+	tagResponses := make([]models.TagResponse, len(tags))
+	for i, tag := range tags {
+		tagResponses[i] = tag.ToResponse()
+	}
+
+	return tagResponses, nil
+}
+
+func (t *tagService) UpdateTag(ctx context.Context, userID, tagID string, payload models.TagPayload) (*models.TagResponse, error) {
+	name, ok := normalizeLabel(payload.Name, models.MaxTagNameLength)
+	if !ok {
+		return nil, models.ErrInvalidTagName
+	}
+
+	tag, err := t.getUserTag(ctx, userID, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	tag.Name = name
+	tag.UpdatedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+
+	if err := t.tr.UpdateTag(ctx, *tag); err != nil {
+		if err == models.ErrTagAlreadyExists {
+			return nil, err
+		}
+		return nil, fmt.Errorf("update tag: %w", err)
+	}
+
+	response := tag.ToResponse()
+	return &response, nil
+}
+
+func (t *tagService) DeleteTag(ctx context.Context, userID, tagID string) error {
+	tag, err := t.getUserTag(ctx, userID, tagID)
+	if err != nil {
+		return err
+	}
+
+	if err := t.tr.DeleteTag(ctx, tag.ID); err != nil {
+		return fmt.Errorf("delete tag: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tagService) GetUserTags(ctx context.Context, userID string, tagIDs []string) ([]models.Tag, error) {
+	unique := make(map[string]struct{}, len(tagIDs))
+	for _, tagID := range tagIDs {
+		unique[tagID] = struct{}{}
+	}
+
+	tags, err := t.tr.GetTagsByIDs(ctx, mapKeys(unique))
+	if err != nil {
+		return nil, fmt.Errorf("get tags by IDs: %w", err)
+	}
+
+	if len(tags) != len(unique) {
+		return nil, models.ErrTagNotFound
+	}
+
+	for _, tag := range tags {
+		if tag.UserID != userID {
+			return nil, models.ErrTagNotBelongToUser
+		}
+	}
+
+	return tags, nil
+}
+
+func (t *tagService) GetLinkTags(ctx context.Context, linkIDs []string) (map[string][]models.Tag, error) {
+	tagsByLink, err := t.tr.GetTagsByLinkIDs(ctx, linkIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get tags by link IDs: %w", err)
+	}
+
+	return tagsByLink, nil
+}
+
+func (t *tagService) getUserTag(ctx context.Context, userID, tagID string) (*models.Tag, error) {
+	tag, err := t.tr.GetTagByID(ctx, tagID)
+	if err != nil {
+		return nil, fmt.Errorf("get tag by ID: %w", err)
+	}
+
+	if tag == nil {
+		return nil, models.ErrTagNotFound
+	}
+
+	if tag.UserID != userID {
+		return nil, models.ErrTagNotBelongToUser
+	}
+
+	return tag, nil
+}
+
+func normalizeLabel(name string, maxLength int) (string, bool) {
+	name = strings.Join(strings.Fields(name), " ")
+
+	if name == "" || utf8.RuneCountInString(name) > maxLength {
+		return "", false
+	}
+
+	return name, true
+}
+
+func mapKeys(values map[string]struct{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateTag(t *testing.T) {
+	t.Run("when the name is valid, it should collapse whitespace and create the tag", func(t *testing.T) {
+		mockTagRepository := new(mocks.TagRepositoryMock)
+		service := &tagService{tr: mockTagRepository}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockTagRepository.On("CreateTag", ctx, mock.MatchedBy(func(tag models.Tag) bool {
+			return tag.UserID == userID && tag.Name == "spring campaign"
+		})).Return(nil)
+
+		response, err := service.CreateTag(ctx, userID, models.TagPayload{Name: "  spring   campaign "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "spring campaign", response.Name)
+		mockTagRepository.AssertExpectations(t)
+	})
+
+	t.Run("when the name is blank, it should return ErrInvalidTagName", func(t *testing.T) {
+		mockTagRepository := new(mocks.TagRepositoryMock)
+		service := &tagService{tr: mockTagRepository}
+
+		_, err := service.CreateTag(context.Background(), uuid.New().String(), models.TagPayload{Name: "   "})
+
+		assert.Equal(t, models.ErrInvalidTagName, err)
+		mockTagRepository.AssertNotCalled(t, "CreateTag", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when the tag already exists, it should return ErrTagAlreadyExists", func(t *testing.T) {
+		mockTagRepository := new(mocks.TagRepositoryMock)
+		service := &tagService{tr: mockTagRepository}
+
+		ctx := context.Background()
+
+		mockTagRepository.On("CreateTag", ctx, mock.Anything).Return(models.ErrTagAlreadyExists)
+
+		_, err := service.CreateTag(ctx, uuid.New().String(), models.TagPayload{Name: "promo"})
+
+		assert.Equal(t, models.ErrTagAlreadyExists, err)
+	})
+}
+
+func TestDeleteTag(t *testing.T) {
+	t.Run("when the tag belongs to another user, it should return ErrTagNotBelongToUser", func(t *testing.T) {
+		mockTagRepository := new(mocks.TagRepositoryMock)
+		service := &tagService{tr: mockTagRepository}
+
+		ctx := context.Background()
+		tagID := uuid.New().String()
+
+		mockTagRepository.On("GetTagByID", ctx, tagID).Return(&models.Tag{ID: tagID, UserID: uuid.New().String()}, nil)
+
+		err := service.DeleteTag(ctx, uuid.New().String(), tagID)
+
+		assert.Equal(t, models.ErrTagNotBelongToUser, err)
+		mockTagRepository.AssertNotCalled(t, "DeleteTag", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetUserTags(t *testing.T) {
+	t.Run("when every tag belongs to the user, it should return them once each", func(t *testing.T) {
+		mockTagRepository := new(mocks.TagRepositoryMock)
+		service := &tagService{tr: mockTagRepository}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		tagID := uuid.New().String()
+
+		mockTagRepository.On("GetTagsByIDs", ctx, []string{tagID}).Return([]models.Tag{{ID: tagID, UserID: userID}}, nil)
+
+		tags, err := service.GetUserTags(ctx, userID, []string{tagID, tagID})
+
+		assert.NoError(t, err)
+		assert.Len(t, tags, 1)
+	})
+
+	t.Run("when a tag does not exist, it should return ErrTagNotFound", func(t *testing.T) {
+		mockTagRepository := new(mocks.TagRepositoryMock)
+		service := &tagService{tr: mockTagRepository}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		tagID := uuid.New().String()
+
+		mockTagRepository.On("GetTagsByIDs", ctx, mock.Anything).Return([]models.Tag{{ID: tagID, UserID: userID}}, nil)
+
+		_, err := service.GetUserTags(ctx, userID, []string{tagID, uuid.New().String()})
+
+		assert.Equal(t, models.ErrTagNotFound, err)
+	})
+
+	t.Run("when a tag belongs to another user, it should return ErrTagNotBelongToUser", func(t *testing.T) {
+		mockTagRepository := new(mocks.TagRepositoryMock)
+		service := &tagService{tr: mockTagRepository}
+
+		ctx := context.Background()
+		tagID := uuid.New().String()
+
+		mockTagRepository.On("GetTagsByIDs", ctx, []string{tagID}).Return([]models.Tag{{ID: tagID, UserID: uuid.New().String()}}, nil)
+
+		_, err := service.GetUserTags(ctx, uuid.New().String(), []string{tagID})
+
+		assert.Equal(t, models.ErrTagNotBelongToUser, err)
+	})
+}
@@ -5,41 +5,43 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/g-villarinho/link-fizz-api/config"
 	"github.com/g-villarinho/link-fizz-api/models"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
-	"github.com/g-villarinho/link-fizz-api/pkgs/ecdsa"
 	"github.com/golang-jwt/jwt/v5"
 )
 
 type TokenService interface {
 	GenerateToken(ctx context.Context, userID, sessionID string, iat, exp time.Time) (string, error)
 	ValidateToken(ctx context.Context, tokenString string) (*models.TokenClaims, error)
+	GenerateLinkAccessToken(ctx context.Context, linkID string, iat, exp time.Time) (string, error)
+	ValidateLinkAccessToken(ctx context.Context, tokenString, linkID string) error
+	GenerateEmailVerificationToken(ctx context.Context, userID, email string, iat, exp time.Time) (string, error)
+	ValidateEmailVerificationToken(ctx context.Context, tokenString string) (*models.EmailVerificationClaims, error)
 }
 
+const (
+	linkAccessAudience        = "link-access"
+	emailVerificationAudience = "email-verification"
+)
+
 type tokenService struct {
 	i  *di.Injector
-	kp ecdsa.EcdsaKeyPair
+	kr KeyRing
 }
 
 func NewTokenService(i *di.Injector) (TokenService, error) {
-	ecdsaKeyPair, err := di.Invoke[ecdsa.EcdsaKeyPair](i)
+	keyRing, err := di.Invoke[KeyRing](i)
 	if err != nil {
-		return nil, fmt.Errorf("invoke ecdsa.EcdsaKeyPair: %w", err)
+		return nil, fmt.Errorf("invoke services.KeyRing: %w", err)
 	}
 
 	return &tokenService{
 		i:  i,
-		kp: ecdsaKeyPair,
+		kr: keyRing,
 	}, nil
 }
 
 func (t *tokenService) GenerateToken(ctx context.Context, userID string, sessionID string, iat time.Time, exp time.Time) (string, error) {
-	privateKey, err := t.kp.ParseECDSAPrivateKey(config.Env.Key.PrivateKey)
-	if err != nil {
-		return "", err
-	}
-
 	claims := jwt.MapClaims{
 		"iss": "link-fizz-app",
 		"sub": userID,
@@ -48,27 +50,11 @@ func (t *tokenService) GenerateToken(ctx context.Context, userID string, session
 		"exp": exp.Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
-	signedToken, err := token.SignedString(privateKey)
-	if err != nil {
-		return "", fmt.Errorf("sign token: %w", err)
-	}
-
-	return signedToken, nil
+	return t.sign(claims)
 }
 
 func (t *tokenService) ValidateToken(ctx context.Context, tokenString string) (*models.TokenClaims, error) {
-	publicKey, err := t.kp.ParseECDSAPublicKey(config.Env.Key.PublicKey)
-	if err != nil {
-		return nil, fmt.Errorf("parse public key: %w", err)
-	}
-
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return publicKey, nil
-	})
+	token, err := jwt.Parse(tokenString, t.verificationKey)
 
 	if err != nil {
 		return nil, fmt.Errorf("parse token: %w", err)
@@ -93,3 +79,102 @@ func (t *tokenService) ValidateToken(ctx context.Context, tokenString string) (*
 
 	return nil, fmt.Errorf("invalid token")
 }
+
+func (t *tokenService) GenerateLinkAccessToken(ctx context.Context, linkID string, iat time.Time, exp time.Time) (string, error) {
+	claims := jwt.MapClaims{
+		"iss": "link-fizz-app",
+		"aud": linkAccessAudience,
+		"sub": linkID,
+		"iat": iat.Unix(),
+		"exp": exp.Unix(),
+	}
+
+	return t.sign(claims)
+}
+
+func (t *tokenService) ValidateLinkAccessToken(ctx context.Context, tokenString string, linkID string) error {
+	_, err := jwt.Parse(tokenString, t.verificationKey, jwt.WithAudience(linkAccessAudience), jwt.WithSubject(linkID))
+	if err != nil {
+		return fmt.Errorf("parse token: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tokenService) GenerateEmailVerificationToken(ctx context.Context, userID string, email string, iat time.Time, exp time.Time) (string, error) {
+	claims := jwt.MapClaims{
+		"iss":   "link-fizz-app",
+		"aud":   emailVerificationAudience,
+		"sub":   userID,
+		"email": email,
+		"iat":   iat.Unix(),
+		"exp":   exp.Unix(),
+	}
+
+	return t.sign(claims)
+}
+
+func (t *tokenService) ValidateEmailVerificationToken(ctx context.Context, tokenString string) (*models.EmailVerificationClaims, error) {
+	token, err := jwt.Parse(tokenString, t.verificationKey, jwt.WithAudience(emailVerificationAudience))
+	if err != nil {
+		return nil, fmt.Errorf("parse token: %w", err)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok || userID == "" {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	email, ok := claims["email"].(string)
+	if !ok || email == "" {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	return &models.EmailVerificationClaims{
+		UserID: userID,
+		Email:  email,
+	}, nil
+}
+
+func (t *tokenService) sign(claims jwt.MapClaims) (string, error) {
+	kid, privateKey := t.kr.SigningKey()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = kid
+
+	signedToken, err := token.SignedString(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("sign token: %w", err)
+	}
+
+	return signedToken, nil
+}
+
+func (t *tokenService) verificationKey(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	kid, found := token.Header["kid"]
+	if !found {
+		// Tokens issued before key rotation carry no kid; try every known key.
+		keySet := jwt.VerificationKeySet{}
+		for _, publicKey := range t.kr.VerificationKeys() {
+			keySet.Keys = append(keySet.Keys, publicKey)
+		}
+
+		return keySet, nil
+	}
+
+	keyID, ok := kid.(string)
+	if !ok {
+		return nil, models.ErrUnknownSigningKey
+	}
+
+	return t.kr.VerificationKey(keyID)
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+)
+
+// UnlockLimiter counts password attempts on protected links, both per
+// client and per link, so a password cannot be guessed online.
+type UnlockLimiter interface {
+	Reserve(linkID, ip string) bool
+	Reset(linkID, ip string)
+}
+
+type unlockAttemptEntry struct {
+	key      string
+	attempts int
+	resetAt  time.Time
+}
+
+type memoryUnlockLimiter struct {
+	mu      sync.Mutex
+	cfg     models.UnlockLimit
+	clients map[string]*list.Element
+	order   *list.List
+	links   map[string]*unlockAttemptEntry
+	now     func() time.Time
+}
+
+func NewUnlockLimiter(i *di.Injector) (UnlockLimiter, error) {
+	return newMemoryUnlockLimiter(config.Env.UnlockLimit), nil
+}
+
+func newMemoryUnlockLimiter(cfg models.UnlockLimit) *memoryUnlockLimiter {
+	if cfg.Size <= 0 {
+		cfg.Size = 1
+	}
+
+	return &memoryUnlockLimiter{
+		cfg:     cfg,
+		clients: make(map[string]*list.Element),
+		order:   list.New(),
+		links:   make(map[string]*unlockAttemptEntry),
+		now:     time.Now,
+	}
+}
+
+// Reserve counts an attempt against both the client and the link before the
+// password is checked, so parallel guesses cannot slip past the limits.
+func (l *memoryUnlockLimiter) Reserve(linkID, ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	client := l.clientEntry(clientAttemptKey(linkID, ip), now)
+	link := l.linkEntry(linkID, now)
+
+	if client.attempts >= l.cfg.MaxAttempts || link.attempts >= l.cfg.LinkMaxAttempts {
+		return false
+	}
+
+	client.attempts++
+	link.attempts++
+
+	return true
+}
+
+// Reset forgets the client's attempts after a successful unlock and gives the
+// link back the attempt it used, so valid visitors do not drain the link budget.
+func (l *memoryUnlockLimiter) Reset(linkID, ip string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.clients[clientAttemptKey(linkID, ip)]; ok {
+		l.removeClient(element)
+	}
+
+	if entry, ok := l.links[linkID]; ok && entry.attempts > 0 {
+		entry.attempts--
+	}
+}
+
+func (l *memoryUnlockLimiter) clientEntry(key string, now time.Time) *unlockAttemptEntry {
+	if element, ok := l.clients[key]; ok {
+		entry := element.Value.(*unlockAttemptEntry)
+		if !now.Before(entry.resetAt) {
+			entry.attempts = 0
+			entry.resetAt = now.Add(l.cfg.Window)
+		}
+		l.order.MoveToFront(element)
+		return entry
+	}
+
+	entry := &unlockAttemptEntry{key: key, resetAt: now.Add(l.cfg.Window)}
+	l.clients[key] = l.order.PushFront(entry)
+
+	for l.order.Len() > l.cfg.Size {
+		l.removeClient(l.order.Back())
+	}
+
+	return entry
+}
+
+// Link counters live outside the client LRU so churning client keys cannot
+// evict them. Only links that exist reach the limiter, and expired counters
+// are swept whenever the map outgrows the configured size.
+func (l *memoryUnlockLimiter) linkEntry(linkID string, now time.Time) *unlockAttemptEntry {
+	if entry, ok := l.links[linkID]; ok {
+		if !now.Before(entry.resetAt) {
+			entry.attempts = 0
+			entry.resetAt = now.Add(l.cfg.Window)
+		}
+		return entry
+	}
+
+	if len(l.links) >= l.cfg.Size {
+		for key, entry := range l.links {
+			if !now.Before(entry.resetAt) {
+				delete(l.links, key)
+			}
+		}
+	}
+
+	entry := &unlockAttemptEntry{key: linkID, resetAt: now.Add(l.cfg.Window)}
+	l.links[linkID] = entry
+
+	return entry
+}
+
+func (l *memoryUnlockLimiter) removeClient(element *list.Element) {
+	l.order.Remove(element)
+	delete(l.clients, element.Value.(*unlockAttemptEntry).key)
+}
+
+func clientAttemptKey(linkID, ip string) string {
+	return linkID + "|" + ip
+}
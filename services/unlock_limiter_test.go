@@ -0,0 +1,79 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryUnlockLimiter(t *testing.T) {
+	t.Run("when a client reaches the attempt limit, it should block that client until the window elapses", func(t *testing.T) {
+		now := time.Now()
+		limiter := newMemoryUnlockLimiter(models.UnlockLimit{Size: 10, MaxAttempts: 2, LinkMaxAttempts: 10, Window: time.Minute})
+		limiter.now = func() time.Time { return now }
+
+		assert.True(t, limiter.Reserve("l1", "1.1.1.1"))
+		assert.True(t, limiter.Reserve("l1", "1.1.1.1"))
+		assert.False(t, limiter.Reserve("l1", "1.1.1.1"))
+		assert.True(t, limiter.Reserve("l1", "2.2.2.2"))
+		assert.True(t, limiter.Reserve("l2", "1.1.1.1"))
+
+		now = now.Add(time.Minute)
+		assert.True(t, limiter.Reserve("l1", "1.1.1.1"))
+	})
+
+	t.Run("when attempts from many clients reach the link limit, it should block every client", func(t *testing.T) {
+		limiter := newMemoryUnlockLimiter(models.UnlockLimit{Size: 10, MaxAttempts: 5, LinkMaxAttempts: 3, Window: time.Minute})
+
+		assert.True(t, limiter.Reserve("l1", "1.1.1.1"))
+		assert.True(t, limiter.Reserve("l1", "2.2.2.2"))
+		assert.True(t, limiter.Reserve("l1", "3.3.3.3"))
+
+		assert.False(t, limiter.Reserve("l1", "4.4.4.4"))
+		assert.True(t, limiter.Reserve("l2", "4.4.4.4"))
+	})
+
+	t.Run("when the client is reset, it should clear the client and refund the link attempt", func(t *testing.T) {
+		limiter := newMemoryUnlockLimiter(models.UnlockLimit{Size: 10, MaxAttempts: 1, LinkMaxAttempts: 2, Window: time.Minute})
+
+		assert.True(t, limiter.Reserve("l1", "1.1.1.1"))
+		limiter.Reset("l1", "1.1.1.1")
+		assert.True(t, limiter.Reserve("l1", "1.1.1.1"))
+		assert.True(t, limiter.Reserve("l1", "2.2.2.2"))
+		assert.False(t, limiter.Reserve("l1", "3.3.3.3"))
+	})
+
+	t.Run("when client keys churn past the size, it should keep the link count", func(t *testing.T) {
+		limiter := newMemoryUnlockLimiter(models.UnlockLimit{Size: 2, MaxAttempts: 5, LinkMaxAttempts: 3, Window: time.Minute})
+
+		for i := range 3 {
+			assert.True(t, limiter.Reserve("l1", fmt.Sprintf("10.0.0.%d", i)))
+		}
+
+		assert.False(t, limiter.Reserve("l1", "10.0.0.99"))
+	})
+
+	t.Run("when guesses run in parallel, it should reserve at most the attempt limit", func(t *testing.T) {
+		limiter := newMemoryUnlockLimiter(models.UnlockLimit{Size: 10, MaxAttempts: 5, LinkMaxAttempts: 100, Window: time.Minute})
+
+		var reserved atomic.Int64
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if limiter.Reserve("l1", "1.1.1.1") {
+					reserved.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int64(5), reserved.Load())
+	})
+}
@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/g-villarinho/link-fizz-api/models"
@@ -18,13 +20,20 @@ type UserService interface {
 	UpdateUser(ctx context.Context, ID string, name, email string) error
 	DeleteUser(ctx context.Context, ID, token string) error
 	UpdatePassword(ctx context.Context, userID, currentPassword, newPassword string) error
+	UpdatePreferences(ctx context.Context, userID string, payload models.UpdatePreferencesPayload) (*models.UserResponse, error)
+	DisableUser(ctx context.Context, ID string) error
+	EnableUser(ctx context.Context, ID string) error
 }
 
 type userService struct {
-	i  *di.Injector
-	ss SecurityService
-	ls LogoutService
-	ur repositories.UserRepository
+	i   *di.Injector
+	ss  SecurityService
+	ls  LogoutService
+	sc  SessionCache
+	lc  LinkCache
+	evs EmailVerificationService
+	ur  repositories.UserRepository
+	lr  repositories.LinkRepository
 }
 
 func NewUserService(i *di.Injector) (UserService, error) {
@@ -38,16 +47,40 @@ func NewUserService(i *di.Injector) (UserService, error) {
 		return nil, fmt.Errorf("invoke services.logout: %w", err)
 	}
 
+	sessionCache, err := di.Invoke[SessionCache](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.sessionCache: %w", err)
+	}
+
+	linkCache, err := di.Invoke[LinkCache](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.linkCache: %w", err)
+	}
+
+	emailVerificationService, err := di.Invoke[EmailVerificationService](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke services.emailVerification: %w", err)
+	}
+
 	userRepository, err := di.Invoke[repositories.UserRepository](i)
 	if err != nil {
 		return nil, fmt.Errorf("invoke repositories.user: %w", err)
 	}
 
+	linkRepository, err := di.Invoke[repositories.LinkRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.link: %w", err)
+	}
+
 	return &userService{
-		i:  i,
-		ss: securityService,
-		ls: logoutService,
-		ur: userRepository,
+		i:   i,
+		ss:  securityService,
+		ls:  logoutService,
+		sc:  sessionCache,
+		lc:  linkCache,
+		evs: emailVerificationService,
+		ur:  userRepository,
+		lr:  linkRepository,
 	}, nil
 }
 
@@ -116,7 +149,8 @@ func (u *userService) UpdateUser(ctx context.Context, ID string, name string, em
 		return models.ErrUserNotFound
 	}
 
-	if user.Email != email {
+	emailChanged := user.Email != email
+	if emailChanged {
 		userFromEmail, err := u.ur.GetUserByEmail(ctx, email)
 		if err != nil {
 			return fmt.Errorf("get user by email: %w", err)
@@ -127,6 +161,8 @@ func (u *userService) UpdateUser(ctx context.Context, ID string, name string, em
 		}
 
 		user.Email = email
+		user.EmailVerifiedAt = sql.NullTime{}
+		user.EmailVerificationSentAt = sql.NullTime{}
 	}
 
 	user.Name = name
@@ -135,10 +171,27 @@ func (u *userService) UpdateUser(ctx context.Context, ID string, name string, em
 		return fmt.Errorf("update user: %w", err)
 	}
 
+	if emailChanged {
+		if err := u.evs.SendVerification(ctx, ID); err != nil {
+			slog.Warn("send verification email", "error", err, "user_id", ID)
+		}
+	}
+
 	return nil
 }
 
 func (u *userService) DeleteUser(ctx context.Context, ID, token string) error {
+	// Read the addresses first so the deleted links can be evicted from the cache.
+	addresses, err := u.lr.GetLinkAddressesByUserID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("get link addresses by user ID: %w", err)
+	}
+
+	// Links on a custom domain block the domain delete that cascades from the user row, so remove them first.
+	if err := u.lr.DeleteLinksByUserID(ctx, ID); err != nil {
+		return fmt.Errorf("delete links by user ID: %w", err)
+	}
+
 	if err := u.ur.DeleteUser(ctx, ID); err != nil {
 		return fmt.Errorf("delete user: %w", err)
 	}
@@ -147,6 +200,12 @@ func (u *userService) DeleteUser(ctx context.Context, ID, token string) error {
 		return fmt.Errorf("create logout: %w", err)
 	}
 
+	u.sc.InvalidateUser(ID)
+
+	for _, address := range addresses {
+		u.lc.Invalidate(address.Domain, address.ShortCode)
+	}
+
 	return nil
 }
 
@@ -177,3 +236,61 @@ func (u *userService) UpdatePassword(ctx context.Context, userID string, current
 
 	return nil
 }
+
+func (u *userService) UpdatePreferences(ctx context.Context, userID string, payload models.UpdatePreferencesPayload) (*models.UserResponse, error) {
+	if payload.DefaultRedirectType != nil && !models.IsValidRedirectType(*payload.DefaultRedirectType) {
+		return nil, models.ErrInvalidRedirectType
+	}
+
+	user, err := u.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user by ID %s: %w", userID, err)
+	}
+
+	if user == nil {
+		return nil, models.ErrUserNotFound
+	}
+
+	if payload.DefaultRedirectType != nil {
+		user.DefaultRedirectType = *payload.DefaultRedirectType
+	}
+
+	if err := u.ur.UpdateUser(ctx, user); err != nil {
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+
+	return user.ToUseResponse(), nil
+}
+
+func (u *userService) DisableUser(ctx context.Context, ID string) error {
+	return u.setDisabledAt(ctx, ID, sql.NullTime{Time: time.Now().UTC(), Valid: true})
+}
+
+func (u *userService) EnableUser(ctx context.Context, ID string) error {
+	return u.setDisabledAt(ctx, ID, sql.NullTime{})
+}
+
+func (u *userService) setDisabledAt(ctx context.Context, ID string, disabledAt sql.NullTime) error {
+	user, err := u.ur.GetUserByID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("get user by ID %s: %w", ID, err)
+	}
+
+	if user == nil {
+		return models.ErrUserNotFound
+	}
+
+	if user.DisabledAt.Valid == disabledAt.Valid {
+		return nil
+	}
+
+	if err := u.ur.UpdateDisabledAt(ctx, ID, disabledAt); err != nil {
+		return fmt.Errorf("update disabled at: %w", err)
+	}
+
+	// Cached session lookups still carry the old status; drop them so the
+	// next request re-reads it.
+	u.sc.InvalidateUser(ID)
+
+	return nil
+}
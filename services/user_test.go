@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteUser(t *testing.T) {
+	t.Run("when the user is deleted, it should evict their links and sessions from the caches", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockLogoutService := new(mocks.LogoutServiceMock)
+		mockLinkCache := new(mocks.LinkCacheMock)
+		mockSessionCache := new(mocks.SessionCacheMock)
+		service := &userService{ur: mockUserRepo, lr: mockLinkRepo, ls: mockLogoutService, lc: mockLinkCache, sc: mockSessionCache}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockLinkRepo.On("GetLinkAddressesByUserID", ctx, userID).Return([]models.LinkAddress{{ShortCode: "abcd1234"}, {Domain: "go.acme.com", ShortCode: "promo"}}, nil)
+		mockLinkRepo.On("DeleteLinksByUserID", ctx, userID).Return(nil)
+		mockUserRepo.On("DeleteUser", ctx, userID).Return(nil)
+		mockLogoutService.On("CreateLogout", ctx, mock.Anything, "token").Return(nil)
+		mockSessionCache.On("InvalidateUser", userID).Return()
+		mockLinkCache.On("Invalidate", "", "abcd1234").Return()
+		mockLinkCache.On("Invalidate", "go.acme.com", "promo").Return()
+
+		err := service.DeleteUser(ctx, userID, "token")
+
+		assert.NoError(t, err)
+		mockLinkCache.AssertExpectations(t)
+		mockSessionCache.AssertExpectations(t)
+	})
+
+	t.Run("when the user owns a verified domain with links on it, it should delete the links before the user", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		mockLogoutService := new(mocks.LogoutServiceMock)
+		mockLinkCache := new(mocks.LinkCacheMock)
+		mockSessionCache := new(mocks.SessionCacheMock)
+		service := &userService{ur: mockUserRepo, lr: mockLinkRepo, ls: mockLogoutService, lc: mockLinkCache, sc: mockSessionCache}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+		var calls []string
+
+		mockLinkRepo.On("GetLinkAddressesByUserID", ctx, userID).Return([]models.LinkAddress{{Domain: "go.acme.com", ShortCode: "promo"}}, nil)
+		mockLinkRepo.On("DeleteLinksByUserID", ctx, userID).Run(func(mock.Arguments) {
+			calls = append(calls, "DeleteLinksByUserID")
+		}).Return(nil)
+		mockUserRepo.On("DeleteUser", ctx, userID).Run(func(mock.Arguments) {
+			calls = append(calls, "DeleteUser")
+		}).Return(nil)
+		mockLogoutService.On("CreateLogout", ctx, mock.Anything, "token").Return(nil)
+		mockSessionCache.On("InvalidateUser", userID).Return()
+		mockLinkCache.On("Invalidate", "go.acme.com", "promo").Return()
+
+		err := service.DeleteUser(ctx, userID, "token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"DeleteLinksByUserID", "DeleteUser"}, calls)
+	})
+
+	t.Run("when deleting the links fails, it should keep the user", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		mockLinkRepo := new(mocks.LinkRepositoryMock)
+		service := &userService{ur: mockUserRepo, lr: mockLinkRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockLinkRepo.On("GetLinkAddressesByUserID", ctx, userID).Return([]models.LinkAddress{}, nil)
+		mockLinkRepo.On("DeleteLinksByUserID", ctx, userID).Return(assert.AnError)
+
+		err := service.DeleteUser(ctx, userID, "token")
+
+		assert.ErrorIs(t, err, assert.AnError)
+		mockUserRepo.AssertNotCalled(t, "DeleteUser", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDisableUser(t *testing.T) {
+	t.Run("when the user is active, it should set disabled_at and evict their cached sessions", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		mockSessionCache := new(mocks.SessionCacheMock)
+		service := &userService{ur: mockUserRepo, sc: mockSessionCache}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockUserRepo.On("GetUserByID", ctx, userID).Return(&models.User{ID: userID}, nil)
+		mockUserRepo.On("UpdateDisabledAt", ctx, userID, mock.MatchedBy(func(disabledAt sql.NullTime) bool {
+			return disabledAt.Valid
+		})).Return(nil)
+		mockSessionCache.On("InvalidateUser", userID).Return()
+
+		err := service.DisableUser(ctx, userID)
+
+		assert.NoError(t, err)
+		mockUserRepo.AssertExpectations(t)
+		mockSessionCache.AssertExpectations(t)
+	})
+
+	t.Run("when the user is already disabled, it should leave it unchanged", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		mockSessionCache := new(mocks.SessionCacheMock)
+		service := &userService{ur: mockUserRepo, sc: mockSessionCache}
+
+		ctx := context.Background()
+		user := &models.User{ID: uuid.New().String(), DisabledAt: sql.NullTime{Time: time.Now(), Valid: true}}
+
+		mockUserRepo.On("GetUserByID", ctx, user.ID).Return(user, nil)
+
+		err := service.DisableUser(ctx, user.ID)
+
+		assert.NoError(t, err)
+		mockUserRepo.AssertNotCalled(t, "UpdateDisabledAt", mock.Anything, mock.Anything, mock.Anything)
+		mockSessionCache.AssertNotCalled(t, "InvalidateUser", mock.Anything)
+	})
+
+	t.Run("when the user does not exist, it should return ErrUserNotFound", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		service := &userService{ur: mockUserRepo}
+
+		ctx := context.Background()
+		userID := uuid.New().String()
+
+		mockUserRepo.On("GetUserByID", ctx, userID).Return(nil, nil)
+
+		assert.Equal(t, models.ErrUserNotFound, service.DisableUser(ctx, userID))
+	})
+}
+
+func TestEnableUser(t *testing.T) {
+	t.Run("when the user is disabled, it should clear disabled_at and evict their cached sessions", func(t *testing.T) {
+		mockUserRepo := new(mocks.UserRepositoryMock)
+		mockSessionCache := new(mocks.SessionCacheMock)
+		service := &userService{ur: mockUserRepo, sc: mockSessionCache}
+
+		ctx := context.Background()
+		user := &models.User{ID: uuid.New().String(), DisabledAt: sql.NullTime{Time: time.Now(), Valid: true}}
+
+		mockUserRepo.On("GetUserByID", ctx, user.ID).Return(user, nil)
+		mockUserRepo.On("UpdateDisabledAt", ctx, user.ID, sql.NullTime{}).Return(nil)
+		mockSessionCache.On("InvalidateUser", user.ID).Return()
+
+		err := service.EnableUser(ctx, user.ID)
+
+		assert.NoError(t, err)
+		mockUserRepo.AssertExpectations(t)
+		mockSessionCache.AssertExpectations(t)
+	})
+}
@@ -1,34 +1,60 @@
 package services
 
 import (
-	"math/rand"
-	"time"
+	"crypto/rand"
+	"fmt"
 
+	"github.com/g-villarinho/link-fizz-api/config"
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
 )
 
-const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+const charset = "abcdefghijklmnopqrstuvwxyz0123456789"
 
 type UtilsService interface {
 	GenerateShortCode(length int) (string, error)
 }
 
 type utilsService struct {
-	i *di.Injector
+	i        *di.Injector
+	alphabet string
 }
 
 func NewUtilsService(i *di.Injector) (UtilsService, error) {
+	alphabet := config.Env.ShortCode.Alphabet
+	if alphabet == "" {
+		alphabet = charset
+	}
+
 	return &utilsService{
-		i: i,
+		i:        i,
+		alphabet: alphabet,
 	}, nil
 }
 
 func (u *utilsService) GenerateShortCode(length int) (string, error) {
-	var seededRand *rand.Rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	size := len(u.alphabet)
+	limit := 256 - 256%size
 
 	b := make([]byte, length)
-	for i := range b {
-		b[i] = charset[seededRand.Intn(len(charset))]
+	buffer := make([]byte, length*2)
+
+	for filled := 0; filled < length; {
+		if _, err := rand.Read(buffer); err != nil {
+			return "", fmt.Errorf("read random bytes: %w", err)
+		}
+
+		for _, value := range buffer {
+			if int(value) >= limit {
+				continue
+			}
+
+			b[filled] = u.alphabet[int(value)%size]
+			filled++
+
+			if filled == length {
+				break
+			}
+		}
 	}
 
 	return string(b), nil
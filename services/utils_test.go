@@ -1,6 +1,7 @@
 package services
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/g-villarinho/link-fizz-api/pkgs/di"
@@ -36,4 +37,14 @@ func TestGenerateShortCode(t *testing.T) {
 			assert.Contains(t, charset, string(char))
 		}
 	})
+
+	t.Run("should only use the configured alphabet", func(t *testing.T) {
+		service := &utilsService{alphabet: "xyz"}
+
+		shortCode, err := service.GenerateShortCode(64)
+
+		assert.NoError(t, err)
+		assert.Len(t, shortCode, 64)
+		assert.Empty(t, strings.Trim(shortCode, "xyz"))
+	})
 }
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/config"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/g-villarinho/link-fizz-api/pkgs/di"
+	"github.com/g-villarinho/link-fizz-api/repositories"
+)
+
+const visitBatchWriteTimeout = 5 * time.Second
+
+type VisitRecorder interface {
+	Enqueue(linkVisit *models.LinkVisit) bool
+	Stats() models.VisitRecorderStats
+	Shutdown(ctx context.Context) error
+}
+
+type visitRecorder struct {
+	lvr    repositories.LinkVisitRepository
+	cfg    models.VisitQueue
+	queue  chan *models.LinkVisit
+	wg     sync.WaitGroup
+	mu     sync.RWMutex
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	closed      bool
+	enqueued    atomic.Int64
+	backpressed atomic.Int64
+	dropped     atomic.Int64
+	written     atomic.Int64
+	failed      atomic.Int64
+	batches     atomic.Int64
+}
+
+func NewVisitRecorder(i *di.Injector) (VisitRecorder, error) {
+	linkVisitRepository, err := di.Invoke[repositories.LinkVisitRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("invoke repositories.LinkVisitRepository: %w", err)
+	}
+
+	return newVisitRecorder(linkVisitRepository, config.Env.VisitQueue), nil
+}
+
+func newVisitRecorder(linkVisitRepository repositories.LinkVisitRepository, cfg models.VisitQueue) *visitRecorder {
+	if cfg.Size <= 0 {
+		cfg.Size = 1
+	}
+
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 1
+	}
+
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Second
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	recorder := &visitRecorder{
+		lvr:    linkVisitRepository,
+		cfg:    cfg,
+		queue:  make(chan *models.LinkVisit, cfg.Size),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	for range cfg.Workers {
+		recorder.wg.Add(1)
+		go recorder.work()
+	}
+
+	return recorder
+}
+
+func (v *visitRecorder) Enqueue(linkVisit *models.LinkVisit) bool {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if v.closed {
+		v.dropped.Add(1)
+		return false
+	}
+
+	select {
+	case v.queue <- linkVisit:
+		v.enqueued.Add(1)
+		return true
+	default:
+	}
+
+	v.backpressed.Add(1)
+
+	if v.cfg.EnqueueTimeout <= 0 {
+		v.dropped.Add(1)
+		return false
+	}
+
+	timer := time.NewTimer(v.cfg.EnqueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case v.queue <- linkVisit:
+		v.enqueued.Add(1)
+		return true
+	case <-timer.C:
+		v.dropped.Add(1)
+		return false
+	}
+}
+
+func (v *visitRecorder) Stats() models.VisitRecorderStats {
+	return models.VisitRecorderStats{
+		QueueDepth:  len(v.queue),
+		QueueSize:   cap(v.queue),
+		Enqueued:    v.enqueued.Load(),
+		Backpressed: v.backpressed.Load(),
+		Dropped:     v.dropped.Load(),
+		Written:     v.written.Load(),
+		Failed:      v.failed.Load(),
+		Batches:     v.batches.Load(),
+	}
+}
+
+func (v *visitRecorder) Shutdown(ctx context.Context) error {
+	v.mu.Lock()
+	if v.closed {
+		v.mu.Unlock()
+		return models.ErrVisitRecorderClosed
+	}
+	v.closed = true
+	close(v.queue)
+	v.mu.Unlock()
+
+	// Writes still running when the drain deadline passes are aborted instead of outliving shutdown.
+	stop := context.AfterFunc(ctx, v.cancel)
+	defer stop()
+
+	done := make(chan struct{})
+	go func() {
+		v.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		v.cancel()
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("drain visit queue: %w", ctx.Err())
+	}
+}
+
+func (v *visitRecorder) work() {
+	defer v.wg.Done()
+
+	ticker := time.NewTicker(v.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*models.LinkVisit, 0, v.cfg.BatchSize)
+
+	for {
+		select {
+		case linkVisit, ok := <-v.queue:
+			if !ok {
+				v.flush(batch)
+				return
+			}
+
+			batch = append(batch, linkVisit)
+			if len(batch) >= v.cfg.BatchSize {
+				v.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				v.flush(batch)
+				batch = batch[:0]
+			}
+		}
+	}
+}
+
+func (v *visitRecorder) flush(batch []*models.LinkVisit) {
+	if len(batch) == 0 {
+		return
+	}
+
+	logger := slog.With(
+		"service", "visit_recorder",
+		"method", "flush",
+	)
+
+	v.batches.Add(1)
+
+	err := v.write(batch)
+	if err == nil {
+		v.written.Add(int64(len(batch)))
+		return
+	}
+
+	logger.Warn("write visit batch, retrying one by one", "error", err, "size", len(batch))
+
+	for i, linkVisit := range batch {
+		if v.ctx.Err() != nil {
+			logger.Error("drain deadline reached, dropping remaining visits", "count", len(batch)-i)
+			v.failed.Add(int64(len(batch) - i))
+			return
+		}
+
+		if err := v.write([]*models.LinkVisit{linkVisit}); err != nil {
+			logger.Error("write visit", "error", err, "link_id", linkVisit.LinkID)
+			v.failed.Add(1)
+			continue
+		}
+
+		v.written.Add(1)
+	}
+}
+
+func (v *visitRecorder) write(linkVisits []*models.LinkVisit) error {
+	ctx, cancel := context.WithTimeout(v.ctx, visitBatchWriteTimeout)
+	defer cancel()
+
+	return v.lvr.CreateLinkVisits(ctx, linkVisits)
+}
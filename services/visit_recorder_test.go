@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/link-fizz-api/mocks"
+	"github.com/g-villarinho/link-fizz-api/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestVisit() *models.LinkVisit {
+	return &models.LinkVisit{ID: uuid.New().String(), LinkID: uuid.New().String(), VisitedAt: time.Now().UTC()}
+}
+
+func TestVisitRecorder(t *testing.T) {
+	t.Run("when the batch size is reached, it should write the batch in one call", func(t *testing.T) {
+		mockVisitRepo := new(mocks.LinkVisitRepositoryMock)
+		written := make(chan []*models.LinkVisit, 1)
+		mockVisitRepo.On("CreateLinkVisits", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { written <- args.Get(1).([]*models.LinkVisit) }).
+			Return(nil)
+
+		recorder := newVisitRecorder(mockVisitRepo, models.VisitQueue{Size: 10, Workers: 1, BatchSize: 3, FlushInterval: time.Hour})
+
+		for range 3 {
+			assert.True(t, recorder.Enqueue(newTestVisit()))
+		}
+
+		select {
+		case batch := <-written:
+			assert.Len(t, batch, 3)
+		case <-time.After(time.Second):
+			t.Fatal("batch was not flushed")
+		}
+
+		assert.NoError(t, recorder.Shutdown(context.Background()))
+		assert.Equal(t, int64(3), recorder.Stats().Written)
+	})
+
+	t.Run("when shutting down, it should drain pending visits", func(t *testing.T) {
+		mockVisitRepo := new(mocks.LinkVisitRepositoryMock)
+		mockVisitRepo.On("CreateLinkVisits", mock.Anything, mock.Anything).Return(nil)
+
+		recorder := newVisitRecorder(mockVisitRepo, models.VisitQueue{Size: 10, Workers: 2, BatchSize: 100, FlushInterval: time.Hour})
+
+		for range 5 {
+			recorder.Enqueue(newTestVisit())
+		}
+
+		err := recorder.Shutdown(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), recorder.Stats().Written)
+		assert.False(t, recorder.Enqueue(newTestVisit()))
+		assert.ErrorIs(t, recorder.Shutdown(context.Background()), models.ErrVisitRecorderClosed)
+	})
+
+	t.Run("when the queue is full, it should apply backpressure and then drop", func(t *testing.T) {
+		mockVisitRepo := new(mocks.LinkVisitRepositoryMock)
+		release := make(chan struct{})
+		mockVisitRepo.On("CreateLinkVisits", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { <-release }).
+			Return(nil)
+
+		recorder := newVisitRecorder(mockVisitRepo, models.VisitQueue{Size: 1, Workers: 1, BatchSize: 1, FlushInterval: time.Hour, EnqueueTimeout: 10 * time.Millisecond})
+
+		accepted := 0
+		for range 5 {
+			if recorder.Enqueue(newTestVisit()) {
+				accepted++
+			}
+		}
+		close(release)
+
+		stats := recorder.Stats()
+		assert.Less(t, accepted, 5)
+		assert.Positive(t, stats.Backpressed)
+		assert.Equal(t, int64(5-accepted), stats.Dropped)
+		assert.NoError(t, recorder.Shutdown(context.Background()))
+	})
+
+	t.Run("when the batch write fails, it should retry each visit individually", func(t *testing.T) {
+		mockVisitRepo := new(mocks.LinkVisitRepositoryMock)
+		failing := newTestVisit()
+		mockVisitRepo.On("CreateLinkVisits", mock.Anything, mock.MatchedBy(func(b []*models.LinkVisit) bool { return len(b) > 1 })).
+			Return(errors.New("deadlock"))
+		mockVisitRepo.On("CreateLinkVisits", mock.Anything, []*models.LinkVisit{failing}).
+			Return(errors.New("bad row"))
+		mockVisitRepo.On("CreateLinkVisits", mock.Anything, mock.Anything).Return(nil)
+
+		recorder := newVisitRecorder(mockVisitRepo, models.VisitQueue{Size: 10, Workers: 1, BatchSize: 100, FlushInterval: time.Hour})
+
+		recorder.Enqueue(newTestVisit())
+		recorder.Enqueue(failing)
+		recorder.Enqueue(newTestVisit())
+
+		err := recorder.Shutdown(context.Background())
+
+		stats := recorder.Stats()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), stats.Written)
+		assert.Equal(t, int64(1), stats.Failed)
+	})
+	t.Run("when the drain deadline passes during a write, it should abort it and drop the remaining visits", func(t *testing.T) {
+		mockVisitRepo := new(mocks.LinkVisitRepositoryMock)
+		mockVisitRepo.On("CreateLinkVisits", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { <-args.Get(0).(context.Context).Done() }).
+			Return(context.Canceled)
+
+		recorder := newVisitRecorder(mockVisitRepo, models.VisitQueue{Size: 10, Workers: 1, BatchSize: 100, FlushInterval: time.Hour})
+
+		for range 3 {
+			recorder.Enqueue(newTestVisit())
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := recorder.Shutdown(ctx)
+		recorder.wg.Wait()
+
+		stats := recorder.Stats()
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int64(0), stats.Written)
+		assert.Equal(t, int64(3), stats.Failed)
+		mockVisitRepo.AssertNumberOfCalls(t, "CreateLinkVisits", 1)
+	})
+}
@@ -26,6 +26,7 @@ func initDeps(i *di.Injector) *sql.DB {
 
 	// Middlewares
 	di.Provide(i, middlewares.NewAuthMiddleware)
+	di.Provide(i, middlewares.NewAdminMiddleware)
 
 	// Config
 	di.Provide(i, ecdsa.NewEcdsaKeyPair)
@@ -35,6 +36,15 @@ func initDeps(i *di.Injector) *sql.DB {
 	di.Provide(i, handlers.NewAuthHandler)
 	di.Provide(i, handlers.NewLinkHandler)
 	di.Provide(i, handlers.NewUserHandler)
+	di.Provide(i, handlers.NewDomainHandler)
+	di.Provide(i, handlers.NewTagHandler)
+	di.Provide(i, handlers.NewFolderHandler)
+	di.Provide(i, handlers.NewLinkRuleHandler)
+	di.Provide(i, handlers.NewSessionHandler)
+	di.Provide(i, handlers.NewJWKSHandler)
+	di.Provide(i, handlers.NewEmailVerificationHandler)
+	di.Provide(i, handlers.NewMetricsHandler)
+	di.Provide(i, handlers.NewUserStatusHandler)
 
 	// Services
 	di.Provide(i, services.NewAuthService)
@@ -42,11 +52,28 @@ func initDeps(i *di.Injector) *sql.DB {
 	di.Provide(i, services.NewUtilsService)
 	di.Provide(i, services.NewUserService)
 	di.Provide(i, services.NewSecurityService)
+	di.Provide(i, services.NewKeyRing)
 	di.Provide(i, services.NewTokenService)
 	di.Provide(i, services.NewLogoutService)
 	di.Provide(i, services.NewRedirectService)
 	di.Provide(i, services.NewLinkVisitService)
 	di.Provide(i, services.NewSessionService)
+	di.Provide(i, services.NewVisitRecorder)
+	di.Provide(i, services.NewLinkCache)
+	di.Provide(i, services.NewSessionCache)
+	di.Provide(i, services.NewUnlockLimiter)
+	di.Provide(i, services.NewQRService)
+	di.Provide(i, services.NewReservedCodeRegistry)
+	di.Provide(i, services.NewShortCodeGenerator)
+	di.Provide(i, services.NewDomainService)
+	di.Provide(i, services.NewDNSResolver)
+	di.Provide(i, services.NewExportService)
+	di.Provide(i, services.NewTagService)
+	di.Provide(i, services.NewFolderService)
+	di.Provide(i, services.NewLinkRuleService)
+	di.Provide(i, services.NewGeoIPLocator)
+	di.Provide(i, services.NewMailer)
+	di.Provide(i, services.NewEmailVerificationService)
 
 	// Repositories
 	di.Provide(i, repositories.NewLinkRepository)
@@ -54,6 +81,11 @@ func initDeps(i *di.Injector) *sql.DB {
 	di.Provide(i, repositories.NewLogoutRepository)
 	di.Provide(i, repositories.NewLinkVisitRepository)
 	di.Provide(i, repositories.NewSessionRepository)
+	di.Provide(i, repositories.NewDomainRepository)
+	di.Provide(i, repositories.NewTagRepository)
+	di.Provide(i, repositories.NewFolderRepository)
+	di.Provide(i, repositories.NewLinkRuleRepository)
+	di.Provide(i, repositories.NewShortCodeRepository)
 
 	return db
 }
@@ -3,20 +3,121 @@ CREATE TABLE IF NOT EXISTS users (
     name VARCHAR(255) NOT NULL,
     email VARCHAR(255) NOT NULL UNIQUE,
     password_hash VARCHAR(255) NOT NULL,
+    default_redirect_type SMALLINT NOT NULL DEFAULT 302,
     created_at TIMESTAMP NOT NULL,
-    updated_at TIMESTAMP NULL
+    updated_at TIMESTAMP NULL,
+    disabled_at TIMESTAMP NULL DEFAULT NULL,
+    email_verified_at TIMESTAMP NULL DEFAULT NULL,
+    email_verification_sent_at TIMESTAMP NULL DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS domains (
+    id CHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    hostname VARCHAR(255) NOT NULL,
+    verification_token VARCHAR(64) NOT NULL,
+    verified_at DATETIME NULL DEFAULT NULL,
+    created_at DATETIME NOT NULL,
+    verified_hostname VARCHAR(255) AS (IF(verified_at IS NULL, NULL, hostname)) STORED,
+
+    UNIQUE KEY uq_domains_user_hostname (user_id, hostname),
+    UNIQUE KEY uq_domains_verified_hostname (verified_hostname),
+    INDEX idx_domains_user (user_id, created_at),
+
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS folders (
+    id CHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NULL DEFAULT NULL,
+
+    UNIQUE KEY uq_folders_user_name (user_id, name),
+
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS tags (
+    id CHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NULL DEFAULT NULL,
+
+    UNIQUE KEY uq_tags_user_name (user_id, name),
+
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
 );
 
 CREATE TABLE IF NOT EXISTS links (
     id VARCHAR(36) PRIMARY KEY,
     original_url TEXT NOT NULL,
     title varchar(100) NULL DEFAULT NULL,
-    short_code VARCHAR(20) NOT NULL UNIQUE,
+    short_code VARCHAR(20) NOT NULL,
     user_id VARCHAR(36) NOT NULL,
     created_at TIMESTAMP NOT NULL,
     updated_at TIMESTAMP NULL,
+    activates_at DATETIME NULL DEFAULT NULL,
+    expires_at DATETIME NULL DEFAULT NULL,
+    fallback_url TEXT NULL DEFAULT NULL,
+    max_clicks INT NULL DEFAULT NULL,
+    click_count INT NOT NULL DEFAULT 0,
+    password_hash VARCHAR(255) NULL DEFAULT NULL,
+    domain VARCHAR(255) NULL DEFAULT NULL,
+    folder_id CHAR(36) NULL DEFAULT NULL,
+    redirect_type SMALLINT NOT NULL DEFAULT 302,
+    sticky_destinations BOOLEAN NOT NULL DEFAULT FALSE,
+    domain_key VARCHAR(255) AS (COALESCE(domain, '')) STORED,
+
+    UNIQUE KEY uq_links_domain_short_code (domain_key, short_code),
+    INDEX idx_links_user_created (user_id, created_at, id),
+    INDEX idx_links_user_clicks (user_id, click_count, id),
+    INDEX idx_links_folder (folder_id),
     
-    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    -- No cascade: a domain with links cannot be deleted, so UserService.DeleteUser removes the user's links before the user row.
+    FOREIGN KEY (domain) REFERENCES domains(verified_hostname),
+    FOREIGN KEY (folder_id) REFERENCES folders(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS link_tags (
+    link_id VARCHAR(36) NOT NULL,
+    tag_id CHAR(36) NOT NULL,
+
+    PRIMARY KEY (link_id, tag_id),
+    INDEX idx_link_tags_tag (tag_id),
+
+    FOREIGN KEY (link_id) REFERENCES links(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS link_destinations (
+    id CHAR(36) PRIMARY KEY,
+    link_id VARCHAR(36) NOT NULL,
+    url TEXT NOT NULL,
+    label VARCHAR(50) NULL DEFAULT NULL,
+    weight INT NOT NULL,
+    position SMALLINT NOT NULL,
+
+    INDEX idx_link_destinations_link (link_id, position),
+
+    FOREIGN KEY (link_id) REFERENCES links(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS link_rules (
+    id CHAR(36) PRIMARY KEY,
+    link_id VARCHAR(36) NOT NULL,
+    position SMALLINT NOT NULL,
+    destination_url TEXT NOT NULL,
+    conditions JSON NOT NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NULL DEFAULT NULL,
+
+    INDEX idx_link_rules_link (link_id, position),
+
+    FOREIGN KEY (link_id) REFERENCES links(id) ON DELETE CASCADE
 );
 
 CREATE TABLE IF NOT EXISTS logouts (
@@ -31,10 +132,19 @@ CREATE TABLE IF NOT EXISTS logouts (
 CREATE TABLE IF NOT EXISTS link_visits (
     id CHAR(36) PRIMARY KEY,
     link_id CHAR(36) NOT NULL,
+    destination_id CHAR(36) NULL DEFAULT NULL,
     ip TEXT NOT NULL,
     agent TEXT NOT NULL,
+    referrer TEXT NULL DEFAULT NULL,
+    country CHAR(2) NULL DEFAULT NULL,
+    region VARCHAR(100) NULL DEFAULT NULL,
+    city VARCHAR(100) NULL DEFAULT NULL,
     visited_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
 
+    INDEX idx_link_visits_link_visited (link_id, visited_at),
+    INDEX idx_link_visits_link_destination (link_id, destination_id),
+    INDEX idx_link_visits_link_country (link_id, country),
+
     
 	FOREIGN KEY (link_id) REFERENCES links(id) ON DELETE CASCADE
 );
@@ -43,11 +153,27 @@ CREATE TABLE IF NOT EXISTS sessions (
   id CHAR(36) PRIMARY KEY,
   user_id CHAR(36) NOT NULL,
   token VARCHAR(512) NOT NULL UNIQUE,
+  refresh_token_hash CHAR(64) NOT NULL UNIQUE,
   ip VARCHAR(45) NOT NULL,
   agent TEXT NOT NULL,
   created_at DATETIME NOT NULL,
   expire_at DATETIME NOT NULL,
+  refreshed_at DATETIME NULL DEFAULT NULL,
 
   
   FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
+
+CREATE TABLE IF NOT EXISTS session_refresh_tokens (
+  session_id CHAR(36) NOT NULL,
+  token_hash CHAR(64) NOT NULL,
+  retired_at DATETIME NOT NULL,
+
+  PRIMARY KEY (session_id, token_hash),
+  FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS short_code_state (
+  id TINYINT PRIMARY KEY,
+  length INT NOT NULL
+);